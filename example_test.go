@@ -22,6 +22,21 @@ func ExampleCommonPrefix() {
 	// (answer, anvil): an
 }
 
+func ExampleLongestCommonSubsequence() {
+	fmt.Println("(AB-1234-X, AB-124-XY):", strutil.LongestCommonSubsequence("AB-1234-X", "AB-124-XY"))
+
+	// Output:
+	// (AB-1234-X, AB-124-XY): AB-124-X
+}
+
+func ExampleLongestCommonSubstring() {
+	substr, offA, offB := strutil.LongestCommonSubstring("blue cotton shirt", "shirt, cotton, blue")
+	fmt.Printf("(blue cotton shirt, shirt, cotton, blue): %q (%d, %d)\n", substr, offA, offB)
+
+	// Output:
+	// (blue cotton shirt, shirt, cotton, blue): " cotton" (4, 6)
+}
+
 func ExampleUniqueSlice() {
 	sample := []string{"a", "b", "a", "b", "b", "c"}
 	fmt.Println("[a b a b b c]:", strutil.UniqueSlice(sample))
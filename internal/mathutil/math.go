@@ -79,3 +79,12 @@ func Maxf(args ...float64) float64 {
 
 	return max
 }
+
+// Abs returns the absolute value of x.
+func Abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -59,6 +59,15 @@ func TestMaxf(t *testing.T) {
 	})
 }
 
+func TestAbs(t *testing.T) {
+	requireEqual(t, [][2]interface{}{
+		{0, mathutil.Abs(0)},
+		{1, mathutil.Abs(1)},
+		{1, mathutil.Abs(-1)},
+		{10, mathutil.Abs(-10)},
+	})
+}
+
 func requireEqual(t *testing.T, inputs [][2]interface{}) {
 	t.Helper()
 
@@ -2,6 +2,38 @@ package ngram
 
 import "github.com/adrg/strutil/internal/mathutil"
 
+// Padding runes used to mark the start and the end of padded terms.
+const (
+	StartPad = '\u0002'
+	EndPad   = '\u0003'
+)
+
+// Pad returns a copy of the provided term, prefixed with size-1 StartPad
+// runes and suffixed with size-1 EndPad runes. Padding the terms ensures
+// that their first and last runes are part of as many n-grams of the
+// specified size as the other runes. Empty terms are not padded. An n-gram
+// size of 1 is used if the provided size is less than or equal to 0.
+func Pad(runes []rune, size int) []rune {
+	// Use an n-gram size of 1 if the provided size is invalid.
+	size = mathutil.Max(size, 1)
+
+	// Check if the term is empty.
+	lenRunes := len(runes)
+	if lenRunes == 0 {
+		return nil
+	}
+
+	// Generate padded term.
+	padded := make([]rune, lenRunes+2*(size-1))
+	for i := 0; i < size-1; i++ {
+		padded[i] = StartPad
+		padded[len(padded)-1-i] = EndPad
+	}
+	copy(padded[size-1:], runes)
+
+	return padded
+}
+
 // Count returns the n-gram count of the specified size for the
 // provided term. An n-gram size of 1 is used if the provided size is
 // less than or equal to 0.
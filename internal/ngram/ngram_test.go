@@ -28,6 +28,23 @@ func TestNgramCount(t *testing.T) {
 	})
 }
 
+func TestNgramPad(t *testing.T) {
+	requireEqual(t, [][2]interface{}{
+		{0, len(ngram.Pad(nil, 2))},
+		{0, len(ngram.Pad([]rune{}, 2))},
+		{"abc", string(ngram.Pad([]rune("abc"), -1))},
+		{"abc", string(ngram.Pad([]rune("abc"), 0))},
+		{"abc", string(ngram.Pad([]rune("abc"), 1))},
+		{"\u0002abc\u0003", string(ngram.Pad([]rune("abc"), 2))},
+		{"\u0002\u0002abc\u0003\u0003", string(ngram.Pad([]rune("abc"), 3))},
+		{"\u0002\u0002a\u0003\u0003", string(ngram.Pad([]rune("a"), 3))},
+		{
+			[]string{"\u0002a", "ab", "b\u0003"},
+			ngram.Slice(ngram.Pad([]rune("ab"), 2), 2),
+		},
+	})
+}
+
 func TestNgrams(t *testing.T) {
 	requireEqual(t, [][2]interface{}{
 		{0, len(ngram.Slice(nil, -1))},
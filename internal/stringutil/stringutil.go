@@ -20,6 +20,98 @@ func CommonPrefix(first, second string) string {
 	return string(sRunes[0:commonLen])
 }
 
+// LongestCommonSubsequence returns the longest common subsequence of the
+// specified strings. A subsequence is a sequence of characters which occur in
+// the same relative order, but not necessarily contiguously. An empty string
+// is returned if the parameters have no characters in common.
+func LongestCommonSubsequence(first, second string) string {
+	fRunes, sRunes := []rune(first), []rune(second)
+	fLen, sLen := len(fRunes), len(sRunes)
+	if fLen == 0 || sLen == 0 {
+		return ""
+	}
+
+	// Calculate the lengths of the longest common subsequences of all the
+	// suffixes of the input strings.
+	lengths := make([][]int, fLen+1)
+	for i := range lengths {
+		lengths[i] = make([]int, sLen+1)
+	}
+
+	for i := fLen - 1; i >= 0; i-- {
+		for j := sLen - 1; j >= 0; j-- {
+			if fRunes[i] == sRunes[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	// Reconstruct the longest common subsequence.
+	lcs := make([]rune, 0, lengths[0][0])
+	for i, j := 0, 0; i < fLen && j < sLen; {
+		switch {
+		case fRunes[i] == sRunes[j]:
+			lcs = append(lcs, fRunes[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return string(lcs)
+}
+
+// LongestCommonSubstring returns the longest common substring of the
+// specified strings, along with its byte offsets in the first and the second
+// string. If there are multiple longest common substrings, the one which
+// occurs first in the second string is returned. An empty string and offsets
+// of 0 are returned if the parameters have no characters in common.
+//
+// The longest common substring is found in linear time, using a suffix
+// automaton built for the first string.
+func LongestCommonSubstring(first, second string) (string, int, int) {
+	fRunes, sRunes := []rune(first), []rune(second)
+	if len(fRunes) == 0 || len(sRunes) == 0 {
+		return "", 0, 0
+	}
+
+	// Match the second string against the suffix automaton of the first one,
+	// keeping track of the longest match.
+	sa := newSuffixAutomaton(fRunes)
+
+	var state, length, maxLen, fEnd, sEnd int
+	for i, r := range sRunes {
+		for state != 0 && !sa.hasTransition(state, r) {
+			state = sa.states[state].link
+			length = sa.states[state].length
+		}
+
+		if next, ok := sa.states[state].next[r]; ok {
+			state = next
+			length++
+		}
+
+		if length > maxLen {
+			maxLen = length
+			fEnd, sEnd = sa.states[state].firstEnd, i
+		}
+	}
+	if maxLen == 0 {
+		return "", 0, 0
+	}
+
+	// Convert rune offsets to byte offsets.
+	fStart, sStart := fEnd-maxLen+1, sEnd-maxLen+1
+	return string(sRunes[sStart : sEnd+1]), len(string(fRunes[:fStart])), len(string(sRunes[:sStart]))
+}
+
 // UniqueSlice returns a slice containing the unique items from the specified
 // string slice. The items in the output slice are in the order in which they
 // occur in the input slice.
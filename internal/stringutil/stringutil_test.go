@@ -28,6 +28,58 @@ func TestCommonPrefix(t *testing.T) {
 	})
 }
 
+func TestLongestCommonSubsequence(t *testing.T) {
+	requireEqual(t, [][2]interface{}{
+		{"", stringutil.LongestCommonSubsequence("", "")},
+		{"", stringutil.LongestCommonSubsequence("a", "")},
+		{"", stringutil.LongestCommonSubsequence("", "b")},
+		{"", stringutil.LongestCommonSubsequence("a", "b")},
+		{"a", stringutil.LongestCommonSubsequence("a", "a")},
+		{"ab", stringutil.LongestCommonSubsequence("ab", "aab")},
+		{"ace", stringutil.LongestCommonSubsequence("abcde", "ace")},
+		{"ace", stringutil.LongestCommonSubsequence("ace", "abcde")},
+		{"MJAU", stringutil.LongestCommonSubsequence("XMJYAUZ", "MZJAWXU")},
+		{"忧的乌龟", stringutil.LongestCommonSubsequence("忧郁的乌龟", "忧的乌龟")},
+		{"a\u2019c", stringutil.LongestCommonSubsequence("a\u2019bc", "a\u2019dc")},
+		{"abcd", stringutil.LongestCommonSubsequence("abc\u2019d", "abc\u2020d")},
+	})
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	inputs := []*struct {
+		first     string
+		second    string
+		expSubstr string
+		expFirst  int
+		expSecond int
+	}{
+		{first: "", second: ""},
+		{first: "a", second: ""},
+		{first: "", second: "b"},
+		{first: "a", second: "b"},
+		{first: "a", second: "a", expSubstr: "a"},
+		{first: "abcdxyz", second: "xyzabcd", expSubstr: "abcd", expSecond: 3},
+		{first: "xyzabcd", second: "abcdxyz", expSubstr: "abcd", expFirst: 3},
+		{first: "ababc", second: "babca", expSubstr: "babc", expFirst: 1},
+		{first: "zxabcdezy", second: "yzabcdezx", expSubstr: "abcdez", expFirst: 2, expSecond: 2},
+		{first: "abab", second: "baba", expSubstr: "bab", expFirst: 1},
+		{first: "abc", second: "cba", expSubstr: "c", expFirst: 2},
+		{first: "abcbc", second: "xbcbx", expSubstr: "bcb", expFirst: 1, expSecond: 1},
+		{first: "abcbc", second: "cbcx", expSubstr: "cbc", expFirst: 2},
+		{first: "abbb", second: "xbbx", expSubstr: "bb", expFirst: 1, expSecond: 1},
+		{first: "abbb", second: "bbba", expSubstr: "bbb", expFirst: 1},
+		{first: "忧郁的乌龟", second: "的乌龟忧", expSubstr: "的乌龟", expFirst: 6},
+		{first: "a\u2019bc", second: "x\u2019bcd", expSubstr: "\u2019bc", expFirst: 1, expSecond: 1},
+	}
+
+	for _, input := range inputs {
+		substr, first, second := stringutil.LongestCommonSubstring(input.first, input.second)
+		require.Equal(t, input.expSubstr, substr)
+		require.Equal(t, input.expFirst, first)
+		require.Equal(t, input.expSecond, second)
+	}
+}
+
 func TestUniqueSlice(t *testing.T) {
 	requireEqual(t, [][2]interface{}{
 		{0, len(stringutil.UniqueSlice(nil))},
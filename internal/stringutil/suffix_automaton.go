@@ -0,0 +1,80 @@
+package stringutil
+
+type suffixState struct {
+	length   int
+	link     int
+	firstEnd int
+	next     map[rune]int
+}
+
+// suffixAutomaton represents the minimal deterministic automaton which
+// accepts all the suffixes of a term. Each state of the automaton corresponds
+// to a set of substrings of the term which end at the same set of positions.
+type suffixAutomaton struct {
+	states []suffixState
+	last   int
+}
+
+func newSuffixAutomaton(runes []rune) *suffixAutomaton {
+	sa := &suffixAutomaton{
+		states: make([]suffixState, 1, 2*len(runes)+1),
+	}
+	sa.states[0] = suffixState{link: -1, next: map[rune]int{}}
+
+	for i, r := range runes {
+		sa.extend(r, i)
+	}
+
+	return sa
+}
+
+func (sa *suffixAutomaton) extend(r rune, pos int) {
+	cur := len(sa.states)
+	sa.states = append(sa.states, suffixState{
+		length:   sa.states[sa.last].length + 1,
+		firstEnd: pos,
+		next:     map[rune]int{},
+	})
+
+	// Add transitions to the new state from all the suffix states which
+	// do not have a transition for the current rune.
+	p := sa.last
+	for ; p != -1 && !sa.hasTransition(p, r); p = sa.states[p].link {
+		sa.states[p].next[r] = cur
+	}
+
+	switch {
+	case p == -1:
+		sa.states[cur].link = 0
+	case sa.states[p].length+1 == sa.states[sa.states[p].next[r]].length:
+		sa.states[cur].link = sa.states[p].next[r]
+	default:
+		// Split the state reached from p by cloning it.
+		q := sa.states[p].next[r]
+		clone := len(sa.states)
+
+		next := make(map[rune]int, len(sa.states[q].next))
+		for k, v := range sa.states[q].next {
+			next[k] = v
+		}
+		sa.states = append(sa.states, suffixState{
+			length:   sa.states[p].length + 1,
+			link:     sa.states[q].link,
+			firstEnd: sa.states[q].firstEnd,
+			next:     next,
+		})
+
+		for ; p != -1 && sa.states[p].next[r] == q; p = sa.states[p].link {
+			sa.states[p].next[r] = clone
+		}
+		sa.states[q].link = clone
+		sa.states[cur].link = clone
+	}
+
+	sa.last = cur
+}
+
+func (sa *suffixAutomaton) hasTransition(state int, r rune) bool {
+	_, ok := sa.states[state].next[r]
+	return ok
+}
@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// BagDistance represents the bag distance metric for measuring the similarity
+// between sequences. The metric compares the multisets (bags) of characters
+// of the sequences, ignoring the order in which the characters occur.
+//
+// The bag distance is a lower bound of the Levenshtein distance with unit
+// costs and it can be calculated in linear time. For this reason, it can be
+// used as a cheap filter before calculating the Levenshtein distance: if the
+// bag distance of two sequences is greater than the maximum accepted
+// Levenshtein distance, so is their Levenshtein distance and the sequences
+// can be discarded without further computation.
+//
+// For more information see "String Matching with Metric Trees Using an
+// Approximate Distance" by I. Bartolini, P. Ciaccia and M. Patella.
+type BagDistance struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+}
+
+// NewBagDistance returns a new bag distance string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+func NewBagDistance() *BagDistance {
+	return &BagDistance{
+		CaseSensitive: true,
+	}
+}
+
+// Compare returns the bag similarity of a and b. The returned similarity is
+// a number between 0 and 1. Larger similarity numbers indicate closer matches.
+func (m *BagDistance) Compare(a, b string) float64 {
+	distance, maxLen := m.distance(a, b)
+	if maxLen == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance)/float64(maxLen)
+}
+
+// Distance returns the bag distance between a and b, which is the size of
+// the larger of the multiset differences of their characters. Lower distances
+// indicate closer matches. A distance of 0 means the strings are anagrams.
+func (m *BagDistance) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *BagDistance) distance(a, b string) (int, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Count the characters of the first term.
+	lenA, lenB := len(runesA), len(runesB)
+	bag := make(map[rune]int, lenA)
+	for _, r := range runesA {
+		bag[r]++
+	}
+
+	// Remove the characters of the second term from the bag. The characters
+	// which are not found are part of the difference of the second term.
+	var diffB int
+	for _, r := range runesB {
+		if count := bag[r]; count > 0 {
+			bag[r] = count - 1
+			continue
+		}
+		diffB++
+	}
+
+	// The difference of the first term contains the characters of the first
+	// term which were not matched in the second term.
+	diffA := lenA - (lenB - diffB)
+	return mathutil.Max(diffA, diffB), mathutil.Max(lenA, lenB)
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"math"
+	"strings"
+)
+
+// Cosine represents the cosine similarity metric for measuring the similarity
+// between sequences. The compared terms are represented as vectors of n-gram
+// frequencies and the similarity is the cosine of the angle between them.
+// Unlike the Jaccard, Sorensen-Dice and overlap coefficient metrics, cosine
+// similarity takes into account how many times each n-gram occurs in the
+// compared terms.
+//
+// For more information see https://en.wikipedia.org/wiki/Cosine_similarity.
+type Cosine struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// NgramSize represents the size (in characters) of the tokens generated
+	// when comparing the input sequences.
+	NgramSize int
+
+	// Tokenizer represents an optional tokenizer used to split the input
+	// sequences into tokens. If specified, the tokens returned by the
+	// tokenizer (e.g. words) are compared instead of the character n-grams
+	// of the sequences, and the n-gram size is ignored.
+	Tokenizer Tokenizer
+}
+
+// NewCosine returns a new cosine similarity string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	NGramSize: 2
+//	Tokenizer: nil
+func NewCosine() *Cosine {
+	return &Cosine{
+		CaseSensitive: true,
+		NgramSize:     2,
+	}
+}
+
+// Compare returns the cosine similarity of a and b. The returned similarity
+// is a number between 0 and 1. Larger similarity numbers indicate closer
+// matches.
+// An n-gram size of 2 is used if the provided size is less than or equal to 0.
+func (m *Cosine) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	// Check if both terms are empty.
+	runesA, runesB := []rune(a), []rune(b)
+	if len(runesA) == 0 && len(runesB) == 0 {
+		return 1
+	}
+
+	size := m.NgramSize
+	if size <= 0 {
+		size = 2
+	}
+
+	// Calculate token frequency vectors.
+	tokensA, totalA := tokenMap(runesA, size, m.Tokenizer)
+	tokensB, totalB := tokenMap(runesB, size, m.Tokenizer)
+	if totalA == 0 || totalB == 0 {
+		return 0
+	}
+
+	// Calculate dot product and vector magnitudes.
+	var product, normA, normB float64
+	for token, countA := range tokensA {
+		product += float64(countA * tokensB[token])
+		normA += float64(countA * countA)
+	}
+	for _, countB := range tokensB {
+		normB += float64(countB * countB)
+	}
+
+	// Return similarity.
+	return math.Min(product/(math.Sqrt(normA)*math.Sqrt(normB)), 1)
+}
@@ -0,0 +1,227 @@
+package metrics
+
+import (
+	"math"
+	"strings"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// DamerauLevenshtein represents the Damerau-Levenshtein metric for measuring
+// the similarity between sequences. The metric extends the Levenshtein
+// metric by treating the transposition of two adjacent characters as a single
+// edit operation. By default, the metric computes the optimal string
+// alignment distance (also known as the restricted Damerau-Levenshtein
+// distance), in which no substring can be edited more than once. The
+// unrestricted distance can be computed by enabling the Unrestricted option.
+//
+// For more information see https://en.wikipedia.org/wiki/Damerau-Levenshtein_distance.
+type DamerauLevenshtein struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// InsertCost represents the Damerau-Levenshtein cost of a character
+	// insertion.
+	InsertCost int
+
+	// DeleteCost represents the Damerau-Levenshtein cost of a character
+	// deletion.
+	DeleteCost int
+
+	// ReplaceCost represents the Damerau-Levenshtein cost of a character
+	// substitution.
+	ReplaceCost int
+
+	// TransposeCost represents the Damerau-Levenshtein cost of transposing
+	// two adjacent characters.
+	TransposeCost int
+
+	// Unrestricted specifies if the unrestricted Damerau-Levenshtein distance
+	// is computed instead of the optimal string alignment distance. Unlike the
+	// optimal string alignment distance, the unrestricted distance allows
+	// substrings to be edited more than once (e.g. the distance between "ca"
+	// and "abc" is 2 instead of 3) and it satisfies the triangle inequality.
+	// The computed distance is only accurate if twice the transposition cost
+	// is greater than or equal to the sum of the insertion and deletion costs.
+	Unrestricted bool
+
+	// Substitution represents an optional substitution function which is
+	// used to calculate the cost of character substitutions. If specified,
+	// the cost of replacing a character with another one is the replacement
+	// cost scaled by the substitution score of the characters, relative to
+	// the score range of the substitution function. Substitutions of
+	// characters with the maximum score cost nothing, while substitutions of
+	// characters with the minimum score cost the full replacement cost.
+	// Use the Distancef method in order to obtain fractional distances.
+	Substitution Substitution
+}
+
+// NewDamerauLevenshtein returns a new Damerau-Levenshtein string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	InsertCost: 1
+//	DeleteCost: 1
+//	ReplaceCost: 1
+//	TransposeCost: 1
+//	Unrestricted: false
+//	Substitution: nil
+func NewDamerauLevenshtein() *DamerauLevenshtein {
+	return &DamerauLevenshtein{
+		CaseSensitive: true,
+		InsertCost:    1,
+		DeleteCost:    1,
+		ReplaceCost:   1,
+		TransposeCost: 1,
+		Unrestricted:  false,
+	}
+}
+
+// Compare returns the Damerau-Levenshtein similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *DamerauLevenshtein) Compare(a, b string) float64 {
+	distance, maxLen := m.distance(a, b)
+	if maxLen == 0 {
+		return 1
+	}
+
+	return 1 - distance/float64(maxLen)
+}
+
+// Distance returns the Damerau-Levenshtein distance between a and b. Lower
+// distances indicate closer matches. A distance of 0 means the strings are
+// identical. If a substitution function is specified, the distance is rounded
+// to the nearest integer.
+func (m *DamerauLevenshtein) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return int(math.Round(distance))
+}
+
+// Distancef returns the Damerau-Levenshtein distance between a and b as a
+// floating point number. Unlike Distance, the returned distance is not
+// rounded, which is relevant if a substitution function is specified. Lower
+// distances indicate closer matches. A distance of 0 means the strings are
+// identical.
+func (m *DamerauLevenshtein) Distancef(a, b string) float64 {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *DamerauLevenshtein) distance(a, b string) (float64, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if both terms are empty.
+	lenA, lenB := len(runesA), len(runesB)
+	if lenA == 0 && lenB == 0 {
+		return 0, 0
+	}
+
+	// Check if one of the terms is empty.
+	maxLen := mathutil.Max(lenA, lenB)
+	if lenA == 0 {
+		return float64(m.InsertCost * lenB), maxLen
+	}
+	if lenB == 0 {
+		return float64(m.DeleteCost * lenA), maxLen
+	}
+
+	// Calculate distance.
+	if m.Unrestricted {
+		return m.unrestrictedDistance(runesA, runesB), maxLen
+	}
+	return m.restrictedDistance(runesA, runesB), maxLen
+}
+
+func (m *DamerauLevenshtein) restrictedDistance(runesA, runesB []rune) float64 {
+	lenA, lenB := len(runesA), len(runesB)
+	insertCost, deleteCost := float64(m.InsertCost), float64(m.DeleteCost)
+	transposeCost := float64(m.TransposeCost)
+
+	// Initialize cost slices. Besides the current and the previous column,
+	// the column before the previous one is needed in order to account for
+	// transpositions.
+	prevPrevCol := make([]float64, lenB+1)
+	prevCol := make([]float64, lenB+1)
+	for i := 0; i <= lenB; i++ {
+		prevCol[i] = float64(i) * insertCost
+	}
+
+	// Calculate distance.
+	col := make([]float64, lenB+1)
+	for i := 0; i < lenA; i++ {
+		col[0] = float64(i+1) * deleteCost
+		for j := 0; j < lenB; j++ {
+			delCost := prevCol[j+1] + deleteCost
+			insCost := col[j] + insertCost
+			subCost := prevCol[j] + replaceCost(m.Substitution, float64(m.ReplaceCost), runesA, i, runesB, j)
+
+			cost := mathutil.Minf(delCost, insCost, subCost)
+			if i > 0 && j > 0 && runesA[i] == runesB[j-1] && runesA[i-1] == runesB[j] {
+				cost = mathutil.Minf(cost, prevPrevCol[j-1]+transposeCost)
+			}
+			col[j+1] = cost
+		}
+
+		prevPrevCol, prevCol, col = prevCol, col, prevPrevCol
+	}
+
+	return prevCol[lenB]
+}
+
+func (m *DamerauLevenshtein) unrestrictedDistance(runesA, runesB []rune) float64 {
+	lenA, lenB := len(runesA), len(runesB)
+	insertCost, deleteCost := float64(m.InsertCost), float64(m.DeleteCost)
+	transposeCost := float64(m.TransposeCost)
+
+	// Initialize cost matrix. The matrix has an additional row and column
+	// which hold an upper bound of the distance, in order to avoid checking
+	// the bounds of the matrix when looking up transpositions.
+	maxDistance := float64(lenA)*deleteCost + float64(lenB)*insertCost + 1
+	mat := make([][]float64, lenA+2)
+	for i := range mat {
+		mat[i] = make([]float64, lenB+2)
+	}
+
+	mat[0][0] = maxDistance
+	for i := 0; i <= lenA; i++ {
+		mat[i+1][0] = maxDistance
+		mat[i+1][1] = float64(i) * deleteCost
+	}
+	for j := 0; j <= lenB; j++ {
+		mat[0][j+1] = maxDistance
+		mat[1][j+1] = float64(j) * insertCost
+	}
+
+	// Calculate distance. The last row of the first term in which each
+	// character was found is recorded in order to compute transpositions
+	// between non-adjacent characters.
+	lastRows := map[rune]int{}
+	for i := 1; i <= lenA; i++ {
+		var lastMatchCol int
+		for j := 1; j <= lenB; j++ {
+			row, col := lastRows[runesB[j-1]], lastMatchCol
+
+			if runesA[i-1] == runesB[j-1] {
+				lastMatchCol = j
+			}
+
+			mat[i+1][j+1] = mathutil.Minf(
+				mat[i][j]+replaceCost(m.Substitution, float64(m.ReplaceCost), runesA, i-1, runesB, j-1),
+				mat[i+1][j]+insertCost,
+				mat[i][j+1]+deleteCost,
+				mat[row][col]+float64(i-row-1)*deleteCost+transposeCost+float64(j-col-1)*insertCost,
+			)
+		}
+
+		lastRows[runesA[i-1]] = i
+	}
+
+	return mat[lenA+1][lenB+1]
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// editexGroups maps letters to the phonetic groups they belong to. As some
+// letters belong to more than one group, each group is represented by a bit.
+var editexGroups = map[rune]uint16{
+	'a': 1 << 0, 'e': 1 << 0, 'i': 1 << 0, 'o': 1 << 0, 'u': 1 << 0, 'y': 1 << 0,
+	'b': 1 << 1, 'p': 1<<1 | 1<<7,
+	'c': 1<<2 | 1<<9, 'k': 1 << 2, 'q': 1 << 2,
+	'd': 1 << 3, 't': 1 << 3,
+	'l': 1 << 4, 'r': 1 << 4,
+	'm': 1 << 5, 'n': 1 << 5,
+	'g': 1 << 6, 'j': 1 << 6,
+	'f': 1 << 7, 'v': 1 << 7,
+	's': 1<<8 | 1<<9, 'x': 1 << 8, 'z': 1<<8 | 1<<9,
+}
+
+// Editex represents the Editex metric for measuring the similarity between
+// sequences. Editex is an edit distance which takes into account the phonetic
+// properties of letters: substituting letters which belong to the same
+// phonetic group (e.g. "d" and "t") costs less than substituting unrelated
+// letters. Additionally, deleting the silent letters "h" and "w" costs less
+// than deleting other letters. The metric is well suited for matching names.
+//
+// For more information see "Phonetic String Matching: Lessons from
+// Information Retrieval" by J. Zobel and P. Dart.
+type Editex struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	// The phonetic groups of letters are determined regardless of case.
+	CaseSensitive bool
+}
+
+// NewEditex returns a new Editex string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+func NewEditex() *Editex {
+	return &Editex{
+		CaseSensitive: true,
+	}
+}
+
+// Compare returns the Editex similarity of a and b. The returned similarity
+// is a number between 0 and 1. Larger similarity numbers indicate closer
+// matches.
+func (m *Editex) Compare(a, b string) float64 {
+	distance, maxLen := m.distance(a, b)
+	if maxLen == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance)/float64(2*maxLen)
+}
+
+// Distance returns the Editex distance between a and b. Substituting or
+// deleting unrelated characters costs 2, while substituting letters from the
+// same phonetic group or deleting "h" and "w" costs 1. Lower distances
+// indicate closer matches. A distance of 0 means the strings are identical.
+func (m *Editex) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *Editex) distance(a, b string) (int, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	// Prefix the terms with a space, as the cost of deleting a character
+	// depends on the character preceding it.
+	runesA, runesB := []rune(" "+a), []rune(" "+b)
+	lenA, lenB := len(runesA)-1, len(runesB)-1
+
+	// Initialize cost slice.
+	prevCol := make([]int, lenB+1)
+	for j := 1; j <= lenB; j++ {
+		prevCol[j] = prevCol[j-1] + editexDeleteCost(runesB[j-1], runesB[j])
+	}
+
+	// Calculate distance.
+	col := make([]int, lenB+1)
+	for i := 1; i <= lenA; i++ {
+		delCost := editexDeleteCost(runesA[i-1], runesA[i])
+
+		col[0] = prevCol[0] + delCost
+		for j := 1; j <= lenB; j++ {
+			col[j] = mathutil.Min(
+				prevCol[j]+delCost,
+				col[j-1]+editexDeleteCost(runesB[j-1], runesB[j]),
+				prevCol[j-1]+editexReplaceCost(runesA[i], runesB[j]),
+			)
+		}
+
+		col, prevCol = prevCol, col
+	}
+
+	return prevCol[lenB], mathutil.Max(lenA, lenB)
+}
+
+func editexReplaceCost(a, b rune) int {
+	if a == b {
+		return 0
+	}
+	if editexGroups[unicode.ToLower(a)]&editexGroups[unicode.ToLower(b)] != 0 {
+		return 1
+	}
+
+	return 2
+}
+
+func editexDeleteCost(prev, r rune) int {
+	if prev != r {
+		if lower := unicode.ToLower(prev); lower == 'h' || lower == 'w' {
+			return 1
+		}
+	}
+
+	return editexReplaceCost(prev, r)
+}
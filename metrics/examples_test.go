@@ -2,10 +2,37 @@ package metrics_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/adrg/strutil/metrics"
 )
 
+func ExampleEditex() {
+	// Default options.
+	e := metrics.NewEditex()
+
+	sim := e.Compare("Niall", "Neil")
+	fmt.Printf("(Niall, Neil) similarity: %.2f\n", sim)
+
+	dist := e.Distance("Niall", "Neil")
+	fmt.Printf("(Niall, Neil) distance: %d\n", dist)
+
+	// Custom options.
+	e.CaseSensitive = false
+
+	sim = e.Compare("SMITH", "smyth")
+	fmt.Printf("(SMITH, smyth) similarity: %.2f\n", sim)
+
+	dist = e.Distance("SMITH", "smyth")
+	fmt.Printf("(SMITH, smyth) distance: %d\n", dist)
+
+	// Output:
+	// (Niall, Neil) similarity: 0.80
+	// (Niall, Neil) distance: 2
+	// (SMITH, smyth) similarity: 0.90
+	// (SMITH, smyth) distance: 1
+}
+
 func ExampleHamming() {
 	// Default options.
 	h := metrics.NewHamming()
@@ -32,6 +59,27 @@ func ExampleHamming() {
 	// (ONE, once) distance: 2
 }
 
+func ExampleHammingBytes() {
+	dist, sim := metrics.HammingBytes([]byte{0x0f, 0xaa}, []byte{0xff, 0xaa})
+	fmt.Printf("([0f aa], [ff aa]) distance: %d\n", dist)
+	fmt.Printf("([0f aa], [ff aa]) similarity: %.2f\n", sim)
+
+	// Output:
+	// ([0f aa], [ff aa]) distance: 4
+	// ([0f aa], [ff aa]) similarity: 0.75
+}
+
+func ExampleHammingUint64() {
+	// Compare 64-bit fingerprints (e.g. SimHash values).
+	dist, sim := metrics.HammingUint64(0x9f3c61d2a4b8e075, 0x9f3c61d2a4b8e0f5)
+	fmt.Printf("distance: %d\n", dist)
+	fmt.Printf("similarity: %.2f\n", sim)
+
+	// Output:
+	// distance: 1
+	// similarity: 0.98
+}
+
 func ExampleLevenshtein() {
 	// Default options.
 	lev := metrics.NewLevenshtein()
@@ -59,6 +107,220 @@ func ExampleLevenshtein() {
 	// (HELLO, jello) distance: 2
 }
 
+func ExampleBagDistance() {
+	// Default options.
+	bd := metrics.NewBagDistance()
+
+	sim := bd.Compare("kitten", "sitting")
+	fmt.Printf("(kitten, sitting) similarity: %.2f\n", sim)
+
+	dist := bd.Distance("kitten", "sitting")
+	fmt.Printf("(kitten, sitting) distance: %d\n", dist)
+
+	// Custom options.
+	bd.CaseSensitive = false
+
+	dist = bd.Distance("LISTEN", "silent")
+	fmt.Printf("(LISTEN, silent) distance: %d\n", dist)
+
+	// Output:
+	// (kitten, sitting) similarity: 0.57
+	// (kitten, sitting) distance: 3
+	// (LISTEN, silent) distance: 0
+}
+
+func ExampleBagDistance_prefilter() {
+	bd := metrics.NewBagDistance()
+	lev := metrics.NewLevenshtein()
+
+	// The bag distance is a lower bound of the Levenshtein distance, so
+	// candidates with a bag distance greater than the maximum accepted
+	// distance can be skipped without calculating the Levenshtein distance.
+	maxDistance := 2
+	for _, candidate := range []string{"sitting", "mitten", "netkit", "written"} {
+		if bd.Distance("kitten", candidate) > maxDistance {
+			fmt.Printf("%s: skipped\n", candidate)
+			continue
+		}
+
+		if dist := lev.Distance("kitten", candidate); dist <= maxDistance {
+			fmt.Printf("%s: match (distance %d)\n", candidate, dist)
+		} else {
+			fmt.Printf("%s: no match (distance %d)\n", candidate, dist)
+		}
+	}
+
+	// Output:
+	// sitting: skipped
+	// mitten: match (distance 1)
+	// netkit: no match (distance 5)
+	// written: match (distance 2)
+}
+
+func ExampleDamerauLevenshtein() {
+	// Default options.
+	dl := metrics.NewDamerauLevenshtein()
+
+	sim := dl.Compare("hte", "the")
+	fmt.Printf("(hte, the) similarity: %.2f\n", sim)
+
+	dist := dl.Distance("hte", "the")
+	fmt.Printf("(hte, the) distance: %d\n", dist)
+
+	// Custom options.
+	dl.CaseSensitive = false
+	dl.TransposeCost = 2
+
+	sim = dl.Compare("HTE", "the")
+	fmt.Printf("(HTE, the) similarity: %.2f\n", sim)
+
+	dist = dl.Distance("HTE", "the")
+	fmt.Printf("(HTE, the) distance: %d\n", dist)
+
+	// Output:
+	// (hte, the) similarity: 0.67
+	// (hte, the) distance: 1
+	// (HTE, the) similarity: 0.33
+	// (HTE, the) distance: 2
+}
+
+func ExampleDamerauLevenshtein_unrestricted() {
+	dl := metrics.NewDamerauLevenshtein()
+
+	// Optimal string alignment distance.
+	dist := dl.Distance("ca", "abc")
+	fmt.Printf("(ca, abc) restricted distance: %d\n", dist)
+
+	// Unrestricted distance.
+	dl.Unrestricted = true
+
+	dist = dl.Distance("ca", "abc")
+	fmt.Printf("(ca, abc) unrestricted distance: %d\n", dist)
+
+	// Output:
+	// (ca, abc) restricted distance: 3
+	// (ca, abc) unrestricted distance: 2
+}
+
+func ExampleKeyboard() {
+	lev := metrics.NewLevenshtein()
+
+	// Default substitution costs.
+	dist := lev.Distancef("hello", "hrllo")
+	fmt.Printf("(hello, hrllo) distance: %.2f\n", dist)
+
+	// Keyboard proximity substitution costs.
+	lev.Substitution = metrics.NewKeyboard(metrics.QWERTY)
+
+	dist = lev.Distancef("hello", "hrllo")
+	fmt.Printf("(hello, hrllo) QWERTY distance: %.2f\n", dist)
+
+	dist = lev.Distancef("hello", "hxllo")
+	fmt.Printf("(hello, hxllo) QWERTY distance: %.2f\n", dist)
+
+	// Custom keyboard layout.
+	layout := metrics.NewKeyboardLayout([]string{
+		"123",
+		"456",
+		"789",
+		"*0#",
+	}, []float64{0, 0, 0, 0})
+	lev.Substitution = metrics.NewKeyboard(layout)
+
+	dist = lev.Distancef("0723", "0753")
+	fmt.Printf("(0723, 0753) keypad distance: %.2f\n", dist)
+
+	// Output:
+	// (hello, hrllo) distance: 1.00
+	// (hello, hrllo) QWERTY distance: 0.50
+	// (hello, hxllo) QWERTY distance: 1.00
+	// (0723, 0753) keypad distance: 0.50
+}
+
+func ExampleLCS() {
+	// Default options.
+	lcs := metrics.NewLCS()
+
+	sim := lcs.Compare("AB-1234-X", "AB-124-XY")
+	fmt.Printf("(AB-1234-X, AB-124-XY) similarity: %.2f\n", sim)
+
+	dist := lcs.Distance("AB-1234-X", "AB-124-XY")
+	fmt.Printf("(AB-1234-X, AB-124-XY) distance: %d\n", dist)
+
+	// Custom options.
+	lcs.CaseSensitive = false
+
+	sim = lcs.Compare("ab-1234-x", "AB-124-XY")
+	fmt.Printf("(ab-1234-x, AB-124-XY) similarity: %.2f\n", sim)
+
+	// Output:
+	// (AB-1234-X, AB-124-XY) similarity: 0.89
+	// (AB-1234-X, AB-124-XY) distance: 2
+	// (ab-1234-x, AB-124-XY) similarity: 0.89
+}
+
+func ExampleLongestCommonSubstring() {
+	// Default options.
+	lcs := metrics.NewLongestCommonSubstring()
+
+	sim := lcs.Compare("blue cotton shirt", "shirt, cotton, blue")
+	fmt.Printf("(blue cotton shirt, shirt, cotton, blue) similarity: %.2f\n", sim)
+
+	// Custom options.
+	lcs.CaseSensitive = false
+
+	sim = lcs.Compare("Blue Cotton Shirt", "blue cotton t-shirt")
+	fmt.Printf("(Blue Cotton Shirt, blue cotton t-shirt) similarity: %.2f\n", sim)
+
+	// Output:
+	// (blue cotton shirt, shirt, cotton, blue) similarity: 0.39
+	// (Blue Cotton Shirt, blue cotton t-shirt) similarity: 0.67
+}
+
+// serialSubstitution is a substitution function which scores characters
+// commonly confused in serial numbers as near matches.
+type serialSubstitution struct{}
+
+func (serialSubstitution) Compare(a []rune, idxA int, b []rune, idxB int) float64 {
+	switch ra, rb := a[idxA], b[idxB]; {
+	case ra == rb:
+		return 1
+	case ra == '0' && rb == 'O', ra == 'O' && rb == '0':
+		return 0.9
+	case ra == '1' && rb == 'l', ra == 'l' && rb == '1':
+		return 0.8
+	default:
+		return 0
+	}
+}
+
+func (serialSubstitution) Max() float64 {
+	return 1
+}
+
+func (serialSubstitution) Min() float64 {
+	return 0
+}
+
+func ExampleLevenshtein_substitution() {
+	lev := metrics.NewLevenshtein()
+	lev.Substitution = serialSubstitution{}
+
+	dist := lev.Distancef("SN-100", "SN-l0O")
+	fmt.Printf("(SN-100, SN-l0O) distance: %.2f\n", dist)
+
+	dist = lev.Distancef("SN-100", "SN-200")
+	fmt.Printf("(SN-100, SN-200) distance: %.2f\n", dist)
+
+	sim := lev.Compare("SN-100", "SN-l0O")
+	fmt.Printf("(SN-100, SN-l0O) similarity: %.2f\n", sim)
+
+	// Output:
+	// (SN-100, SN-l0O) distance: 0.30
+	// (SN-100, SN-200) distance: 1.00
+	// (SN-100, SN-l0O) similarity: 0.95
+}
+
 func ExampleJaro() {
 	jaro := metrics.NewJaro()
 	sim := jaro.Compare("sort", "shirt")
@@ -91,6 +353,71 @@ func ExampleJaroWinkler() {
 	// (think, TANK) similarity: 0.80
 }
 
+func ExampleJaroWinkler_parameters() {
+	jw := metrics.NewJaroWinkler()
+	jw.PrefixScale = 0.2
+	jw.MaxPrefixLength = 2
+
+	sim := jw.Compare("dixon", "dicksonx")
+	fmt.Printf("(dixon, dicksonx) similarity: %.2f\n", sim)
+
+	// Apply the long strings adjustment.
+	jw = metrics.NewJaroWinkler()
+	jw.LongStrings = true
+
+	sim = jw.Compare("martha", "marhta")
+	fmt.Printf("(martha, marhta) similarity: %.2f\n", sim)
+
+	// Output:
+	// (dixon, dicksonx) similarity: 0.86
+	// (martha, marhta) similarity: 0.97
+}
+
+func ExampleRatcliffObershelp() {
+	// Default options.
+	ro := metrics.NewRatcliffObershelp()
+
+	sim := ro.Compare("wikimedia", "wikimania")
+	fmt.Printf("(wikimedia, wikimania) similarity: %.2f\n", sim)
+
+	// Custom options.
+	ro.CaseSensitive = false
+	ro.IsJunk = func(r rune) bool {
+		return r == ' '
+	}
+
+	sim = ro.Compare(" ABCD", "abcd abcd")
+	fmt.Printf("( ABCD, abcd abcd) similarity: %.2f\n", sim)
+
+	// Output:
+	// (wikimedia, wikimania) similarity: 0.78
+	// ( ABCD, abcd abcd) similarity: 0.57
+}
+
+func ExampleSift4() {
+	// Default options.
+	s := metrics.NewSift4()
+
+	sim := s.Compare("this is a test", "this is a tset")
+	fmt.Printf("(this is a test, this is a tset) similarity: %.2f\n", sim)
+
+	dist := s.Distance("this is a test", "this is a tset")
+	fmt.Printf("(this is a test, this is a tset) distance: %d\n", dist)
+
+	// Custom options.
+	s.CaseSensitive = false
+	s.MaxOffset = 10
+	s.MaxDistance = 2
+
+	dist = s.Distance("London", "LOND")
+	fmt.Printf("(London, LOND) distance: %d\n", dist)
+
+	// Output:
+	// (this is a test, this is a tset) similarity: 0.93
+	// (this is a test, this is a tset) distance: 1
+	// (London, LOND) distance: 2
+}
+
 func ExampleSmithWatermanGotoh() {
 	// Default options.
 	swg := metrics.NewSmithWatermanGotoh()
@@ -114,6 +441,72 @@ func ExampleSmithWatermanGotoh() {
 	// (a pink kitten, A KITTEN) similarity: 0.94
 }
 
+func ExampleSmithWatermanGotoh_affineGaps() {
+	swg := metrics.NewSmithWatermanGotoh()
+
+	// Linear gap penalty.
+	sim := swg.Compare("main street 12", "main 12")
+	fmt.Printf("(main street 12, main 12) linear gap similarity: %.2f\n", sim)
+
+	// Affine gap penalties.
+	swg.GapOpen = -1
+	swg.GapExtend = -0.1
+
+	sim = swg.Compare("main street 12", "main 12")
+	fmt.Printf("(main street 12, main 12) affine gap similarity: %.2f\n", sim)
+
+	// Output:
+	// (main street 12, main 12) linear gap similarity: 0.71
+	// (main street 12, main 12) affine gap similarity: 0.77
+}
+
+func ExampleNeedlemanWunsch() {
+	// Default options.
+	nw := metrics.NewNeedlemanWunsch()
+
+	sim := nw.Compare("a pink kitten", "a kitten")
+	fmt.Printf("(a pink kitten, a kitten) similarity: %.2f\n", sim)
+
+	// Custom options.
+	nw.CaseSensitive = false
+	nw.GapPenalty = -0.5
+	nw.Substitution = metrics.MatchMismatch{
+		Match:    1,
+		Mismatch: -2,
+	}
+
+	sim = nw.Compare("a pink kitten", "A KITTEN")
+	fmt.Printf("(a pink kitten, A KITTEN) similarity: %.2f\n", sim)
+
+	// Output:
+	// (a pink kitten, a kitten) similarity: 0.62
+	// (a pink kitten, A KITTEN) similarity: 0.81
+}
+
+func ExampleParseSubstitutionMatrix() {
+	matrix, err := metrics.ParseSubstitutionMatrix(strings.NewReader(`
+# Nucleotide substitution matrix.
+   A  C  G  T
+A  5 -4 -4 -4
+C -4  5 -4 -4
+G -4 -4  5 -4
+T -4 -4 -4  5
+`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	swg := metrics.NewSmithWatermanGotoh()
+	swg.Substitution = matrix
+
+	sim := swg.Compare("GATTACA", "GCATGCT")
+	fmt.Printf("(GATTACA, GCATGCT) similarity: %.2f\n", sim)
+
+	// Output:
+	// (GATTACA, GCATGCT) similarity: 0.53
+}
+
 func ExampleSorensenDice() {
 	// Default options.
 	sd := metrics.NewSorensenDice()
@@ -150,6 +543,51 @@ func ExampleJaccard() {
 	// (night, alright) similarity: 0.33
 }
 
+func ExampleOCRConfusion() {
+	ocr := metrics.NewOCRConfusion()
+
+	lev := metrics.NewLevenshtein()
+	lev.Substitution = ocr
+
+	dist := lev.Distancef("INV-0015", "INV-OO1S")
+	fmt.Printf("(INV-0015, INV-OO1S) distance: %.2f\n", dist)
+
+	// Multi-character confusions.
+	dist = lev.Distancef("modern", "rnodern")
+	fmt.Printf("(modern, rnodern) distance: %.2f\n", dist)
+
+	// Custom confusion scores.
+	ocr.Confusions[[2]string{"rn", "m"}] = 1
+
+	dist = lev.Distancef("modern", "rnodern")
+	fmt.Printf("(modern, rnodern) custom distance: %.2f\n", dist)
+
+	// Output:
+	// (INV-0015, INV-OO1S) distance: 0.50
+	// (modern, rnodern) distance: 0.17
+	// (modern, rnodern) custom distance: 0.00
+}
+
+func ExampleJaccard_tokenizer() {
+	// Compare word sets instead of character n-grams.
+	j := metrics.NewJaccard()
+	j.CaseSensitive = false
+	j.Tokenizer = metrics.NewWordTokenizer()
+
+	sim := j.Compare("Red cotton shirt, large", "large shirt cotton blue")
+	fmt.Printf("(Red cotton shirt, large, large shirt cotton blue) similarity: %.2f\n", sim)
+
+	// Compare word bigrams.
+	j.Tokenizer = metrics.NewWordNgramTokenizer(2)
+
+	sim = j.Compare("Red cotton shirt, large", "large red cotton shirt")
+	fmt.Printf("(Red cotton shirt, large, large red cotton shirt) similarity: %.2f\n", sim)
+
+	// Output:
+	// (Red cotton shirt, large, large shirt cotton blue) similarity: 0.60
+	// (Red cotton shirt, large, large red cotton shirt) similarity: 0.50
+}
+
 func ExampleOverlapCoefficient() {
 	// Default options.
 	oc := metrics.NewOverlapCoefficient()
@@ -172,3 +610,184 @@ func ExampleOverlapCoefficient() {
 	// (aa, aaaa) similarity: 1.00
 	// (night, alright) similarity: 0.67
 }
+
+func ExampleCosine() {
+	// Default options.
+	c := metrics.NewCosine()
+	sim := c.Compare("night", "alright")
+	fmt.Printf("(night, alright) similarity: %.2f\n", sim)
+
+	// Repeated n-grams are weighted by their frequency.
+	sim = c.Compare("abab", "ab")
+	fmt.Printf("(abab, ab) similarity: %.2f\n", sim)
+
+	// Custom options.
+	c.CaseSensitive = false
+	c.NgramSize = 3
+
+	sim = c.Compare("night", "ALRIGHT")
+	fmt.Printf("(night, ALRIGHT) similarity: %.2f\n", sim)
+
+	// Output:
+	// (night, alright) similarity: 0.61
+	// (abab, ab) similarity: 0.89
+	// (night, ALRIGHT) similarity: 0.52
+}
+
+func ExampleTversky() {
+	// Default options (equivalent to Sorensen-Dice).
+	tv := metrics.NewTversky()
+	sim := tv.Compare("night", "alright")
+	fmt.Printf("(night, alright) similarity: %.2f\n", sim)
+
+	// Measure how much of the first term is contained in the second one.
+	tv.Alpha = 1
+	tv.Beta = 0
+
+	sim = tv.Compare("make", "time to make haste")
+	fmt.Printf("(make, time to make haste) similarity: %.2f\n", sim)
+
+	sim = tv.Compare("time to make haste", "make")
+	fmt.Printf("(time to make haste, make) similarity: %.2f\n", sim)
+
+	// Output:
+	// (night, alright) similarity: 0.60
+	// (make, time to make haste) similarity: 1.00
+	// (time to make haste, make) similarity: 0.18
+}
+
+func ExampleMongeElkan() {
+	// Default options.
+	me := metrics.NewMongeElkan()
+
+	sim := me.Compare("Smith John A", "John Smith")
+	fmt.Printf("(Smith John A, John Smith) similarity: %.2f\n", sim)
+
+	sim = me.Compare("John Smith", "Smith John A")
+	fmt.Printf("(John Smith, Smith John A) similarity: %.2f\n", sim)
+
+	// Custom options.
+	me.Symmetric = true
+	me.Metric = metrics.NewLevenshtein()
+
+	sim = me.Compare("Smith John A", "Jon Smith")
+	fmt.Printf("(Smith John A, Jon Smith) similarity: %.2f\n", sim)
+
+	// Output:
+	// (Smith John A, John Smith) similarity: 0.67
+	// (John Smith, Smith John A) similarity: 1.00
+	// (Smith John A, Jon Smith) similarity: 0.73
+}
+
+func ExampleTokenSort() {
+	// Default options.
+	ts := metrics.NewTokenSort()
+
+	sim := ts.Compare("new york mets vs atlanta braves", "atlanta braves vs new york mets")
+	fmt.Printf("(new york mets vs atlanta braves, atlanta braves vs new york mets) similarity: %.2f\n", sim)
+
+	sim = ts.Compare("fuzzy was a bear", "fuzzy fuzzy was a bear")
+	fmt.Printf("(fuzzy was a bear, fuzzy fuzzy was a bear) similarity: %.2f\n", sim)
+
+	// Custom options.
+	ts.CaseSensitive = false
+	ts.Tokenizer = metrics.NewWordTokenizer()
+	ts.Metric = metrics.NewLevenshtein()
+
+	sim = ts.Compare("Mets, New York", "new york mets")
+	fmt.Printf("(Mets, New York, new york mets) similarity: %.2f\n", sim)
+
+	// Output:
+	// (new york mets vs atlanta braves, atlanta braves vs new york mets) similarity: 1.00
+	// (fuzzy was a bear, fuzzy fuzzy was a bear) similarity: 0.84
+	// (Mets, New York, new york mets) similarity: 1.00
+}
+
+func ExampleTokenSet() {
+	// Default options.
+	ts := metrics.NewTokenSet()
+
+	sim := ts.Compare("mariners vs angels", "los angeles angels of anaheim at seattle mariners")
+	fmt.Printf("(mariners vs angels, los angeles angels of anaheim at seattle mariners) similarity: %.2f\n", sim)
+
+	sim = ts.Compare("Apple Inc", "Apple Inc. Cupertino Headquarters")
+	fmt.Printf("(Apple Inc, Apple Inc. Cupertino Headquarters) similarity: %.2f\n", sim)
+
+	// Custom options.
+	ts.CaseSensitive = false
+	ts.Tokenizer = metrics.NewWordTokenizer()
+
+	sim = ts.Compare("Apple Inc", "apple inc. Cupertino Headquarters")
+	fmt.Printf("(Apple Inc, apple inc. Cupertino Headquarters) similarity: %.2f\n", sim)
+
+	// Output:
+	// (mariners vs angels, los angeles angels of anaheim at seattle mariners) similarity: 0.91
+	// (Apple Inc, Apple Inc. Cupertino Headquarters) similarity: 0.71
+	// (Apple Inc, apple inc. Cupertino Headquarters) similarity: 1.00
+}
+
+func ExamplePartial() {
+	p := metrics.NewPartial()
+	p.CaseSensitive = false
+
+	memo := "POS PURCHASE AMAZN MKTPLACE SEATTLE WA"
+
+	sim := p.Compare("Amazon Marketplace", memo)
+	fmt.Printf("(Amazon Marketplace, %s) similarity: %.2f\n", memo, sim)
+
+	// Find the part of the memo which matches the company name.
+	align := p.Align("Amazon Mktplace", memo)
+	fmt.Printf("(Amazon Mktplace, %s) similarity: %.2f\n", memo, align.Score)
+	fmt.Printf("matched: %q\n", memo[align.StartB:align.EndB])
+
+	// Output:
+	// (Amazon Marketplace, POS PURCHASE AMAZN MKTPLACE SEATTLE WA) similarity: 0.78
+	// (Amazon Mktplace, POS PURCHASE AMAZN MKTPLACE SEATTLE WA) similarity: 0.93
+	// matched: " AMAZN MKTPLACE"
+}
+
+func ExampleWRatio() {
+	w := metrics.NewWRatio()
+	w.CaseSensitive = false
+
+	sim, scorer := w.Score("this is a test", "This is a test!")
+	fmt.Printf("(this is a test, This is a test!) similarity: %.2f (%s)\n", sim, scorer)
+
+	sim, scorer = w.Score("new york mets vs atlanta braves", "Atlanta Braves vs New York Mets")
+	fmt.Printf("(new york mets vs atlanta braves, Atlanta Braves vs New York Mets) similarity: %.2f (%s)\n", sim, scorer)
+
+	sim, scorer = w.Score("york", "New York Mets")
+	fmt.Printf("(york, New York Mets) similarity: %.2f (%s)\n", sim, scorer)
+
+	// Output:
+	// (this is a test, This is a test!) similarity: 0.97 (ratio)
+	// (new york mets vs atlanta braves, Atlanta Braves vs New York Mets) similarity: 0.95 (token_sort)
+	// (york, New York Mets) similarity: 0.90 (partial)
+}
+
+func ExampleQGram() {
+	// Default options.
+	q := metrics.NewQGram()
+
+	sim := q.Compare("night", "nacht")
+	fmt.Printf("(night, nacht) similarity: %.2f\n", sim)
+
+	dist := q.Distance("night", "nacht")
+	fmt.Printf("(night, nacht) distance: %d\n", dist)
+
+	// Pad the terms, in order to increase the weight of the first and last
+	// characters.
+	q.Padding = true
+
+	sim = q.Compare("night", "nacht")
+	fmt.Printf("(night, nacht) similarity: %.2f\n", sim)
+
+	dist = q.Distance("night", "nacht")
+	fmt.Printf("(night, nacht) distance: %d\n", dist)
+
+	// Output:
+	// (night, nacht) similarity: 0.25
+	// (night, nacht) distance: 6
+	// (night, nacht) similarity: 0.50
+	// (night, nacht) distance: 6
+}
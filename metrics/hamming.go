@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"encoding/binary"
+	"math/bits"
 	"strings"
 )
 
@@ -69,3 +71,45 @@ func (m *Hamming) distance(a, b string) (int, int) {
 
 	return distance, lenB
 }
+
+// HammingBytes returns the bit-level Hamming distance between a and b, along
+// with their Hamming similarity. The distance is the number of bits which
+// differ between the byte slices. If the slices do not have the same length,
+// all the bits of the extra bytes of the longer slice are counted as
+// different. The returned similarity is a number between 0 and 1, obtained
+// by normalizing the distance to the number of bits of the longer slice.
+// Larger similarity numbers indicate closer matches.
+func HammingBytes(a, b []byte) (int, float64) {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	lenA, lenB := len(a), len(b)
+	if lenB == 0 {
+		return 0, 1
+	}
+
+	// Count the differing bits of the common bytes, 8 bytes at a time.
+	var distance, i int
+	for ; i+8 <= lenA; i += 8 {
+		distance += bits.OnesCount64(
+			binary.LittleEndian.Uint64(a[i:]) ^ binary.LittleEndian.Uint64(b[i:]),
+		)
+	}
+	for ; i < lenA; i++ {
+		distance += bits.OnesCount8(a[i] ^ b[i])
+	}
+	distance += 8 * (lenB - lenA)
+
+	return distance, 1 - float64(distance)/float64(8*lenB)
+}
+
+// HammingUint64 returns the bit-level Hamming distance between a and b, along
+// with their Hamming similarity. The distance is the number of bits which
+// differ between the two values. The returned similarity is a number between
+// 0 and 1, obtained by normalizing the distance to 64 bits. Larger similarity
+// numbers indicate closer matches. The function is useful for comparing
+// fingerprints, such as SimHash or perceptual hash values.
+func HammingUint64(a, b uint64) (int, float64) {
+	distance := bits.OnesCount64(a ^ b)
+	return distance, 1 - float64(distance)/64
+}
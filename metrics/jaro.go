@@ -14,6 +14,12 @@ import (
 type Jaro struct {
 	// CaseSensitive specifies if the string comparison is case sensitive.
 	CaseSensitive bool
+
+	// MatchWindow represents the maximum distance between the positions of
+	// two equal characters in the compared terms, in order for the characters
+	// to be considered matching. If the match window is less than or equal
+	// to 0, the standard match window, max(len(a), len(b))/2 - 1, is used.
+	MatchWindow int
 }
 
 // NewJaro returns a new Jaro string metric.
@@ -21,46 +27,59 @@ type Jaro struct {
 // Default options:
 //
 //	CaseSensitive: true
+//	MatchWindow: 0
 func NewJaro() *Jaro {
 	return &Jaro{
 		CaseSensitive: true,
+		MatchWindow:   0,
 	}
 }
 
 // Compare returns the Jaro similarity of a and b. The returned similarity is
 // a number between 0 and 1. Larger similarity numbers indicate closer matches.
 func (m *Jaro) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	similarity, _ := jaro(a, b, m.MatchWindow)
+	return similarity
+}
+
+// jaro returns the Jaro similarity of a and b, along with the number of
+// matching characters. The standard match window is used if the specified
+// window is less than or equal to 0.
+func jaro(a, b string, window int) (float64, int) {
 	// Check if both terms are empty.
 	lenA, lenB := utf8.RuneCountInString(a), utf8.RuneCountInString(b)
 	if lenA == 0 && lenB == 0 {
-		return 1
+		return 1, 0
 	}
 
 	// Check if one of the terms is empty.
 	if lenA == 0 || lenB == 0 {
-		return 0
-	}
-
-	// Lower terms if case insensitive comparison is specified.
-	if !m.CaseSensitive {
-		a = strings.ToLower(a)
-		b = strings.ToLower(b)
+		return 0, 0
 	}
 
 	// Get matching runes.
-	maxDistance := mathutil.Max(0, mathutil.Max(lenA, lenB)/2-1)
+	maxDistance := window
+	if maxDistance <= 0 {
+		maxDistance = mathutil.Max(0, mathutil.Max(lenA, lenB)/2-1)
+	}
 	mrA := matchingRunes(a, b, maxDistance)
 	mrB := matchingRunes(b, a, maxDistance)
 
 	fmLen, smLen := len(mrA), len(mrB)
 	if fmLen == 0 || smLen == 0 {
-		return 0.0
+		return 0.0, 0
 	}
 
 	// Return similarity.
 	return (float64(fmLen)/float64(lenA) +
 		float64(smLen)/float64(lenB) +
-		float64(fmLen-transpositions(mrA, mrB)/2)/float64(fmLen)) / 3.0
+		float64(fmLen-transpositions(mrA, mrB)/2)/float64(fmLen)) / 3.0, fmLen
 }
 
 func matchingRunes(a, b string, limit int) []rune {
@@ -1,9 +1,12 @@
 package metrics
 
 import (
+	"math"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 
+	"github.com/adrg/strutil/internal/mathutil"
 	"github.com/adrg/strutil/internal/stringutil"
 )
 
@@ -20,6 +23,32 @@ type JaroWinkler struct {
 	// is less than the specified threshold, the Jaro similarity is returned
 	// without applying any score boost to it.
 	Threshold float64
+
+	// MatchWindow represents the maximum distance between the positions of
+	// two equal characters in the compared terms, in order for the characters
+	// to be considered matching. If the match window is less than or equal
+	// to 0, the standard match window, max(len(a), len(b))/2 - 1, is used.
+	MatchWindow int
+
+	// PrefixScale specifies how much the Jaro similarity is boosted for each
+	// character of the common prefix of the compared terms. The prefix scale
+	// should not be larger than 1/MaxPrefixLength, as the boosted similarity
+	// is capped at 1. A prefix scale of 0 disables the prefix bonus. If the
+	// prefix scale is less than 0, the standard scale of 0.1 is used.
+	PrefixScale float64
+
+	// MaxPrefixLength specifies the maximum length of the common prefix taken
+	// into account when applying the prefix bonus. If the maximum prefix
+	// length is less than or equal to 0, the standard length of 4 is used.
+	MaxPrefixLength int
+
+	// LongStrings specifies if Winkler's adjustment for long strings should
+	// be applied. The adjustment further boosts the similarity of terms which
+	// have at least 5 characters, if they have at least two matching
+	// characters besides the common prefix and if more than half of their
+	// remaining characters match. The adjustment is not applied to terms
+	// starting with a digit.
+	LongStrings bool
 }
 
 // NewJaroWinkler returns a new Jaro-Winkler string metric.
@@ -28,10 +57,17 @@ type JaroWinkler struct {
 //
 //	CaseSensitive: true
 //	Threshold: 0.7
+//	MatchWindow: 0
+//	PrefixScale: 0.1
+//	MaxPrefixLength: 4
+//	LongStrings: false
 func NewJaroWinkler() *JaroWinkler {
 	return &JaroWinkler{
-		CaseSensitive: true,
-		Threshold:     0.7,
+		CaseSensitive:   true,
+		Threshold:       0.7,
+		MatchWindow:     0,
+		PrefixScale:     0.1,
+		MaxPrefixLength: 4,
 	}
 }
 
@@ -46,20 +82,42 @@ func (m *JaroWinkler) Compare(a, b string) float64 {
 	}
 
 	// Calculate common prefix.
+	maxPrefixLen := m.MaxPrefixLength
+	if maxPrefixLen <= 0 {
+		maxPrefixLen = 4
+	}
 	lenPrefix := utf8.RuneCountInString(stringutil.CommonPrefix(a, b))
-	if lenPrefix > 4 {
-		lenPrefix = 4
+	if lenPrefix > maxPrefixLen {
+		lenPrefix = maxPrefixLen
 	}
 
 	// Calculate Jaro similarity.
-	jaro := NewJaro()
-	jaro.CaseSensitive = m.CaseSensitive
+	similarity, matches := jaro(a, b, m.MatchWindow)
 
 	// If the Jaro similarity value is less than the configured threshold,
 	// do not apply the prefix bonus.
-	similarity := jaro.Compare(a, b)
 	if similarity < m.Threshold {
 		return similarity
 	}
-	return similarity + (0.1 * float64(lenPrefix) * (1.0 - similarity))
+
+	prefixScale := m.PrefixScale
+	if prefixScale < 0 {
+		prefixScale = 0.1
+	}
+	similarity = math.Min(similarity+prefixScale*float64(lenPrefix)*(1.0-similarity), 1)
+
+	// Apply the long strings adjustment, if specified.
+	if m.LongStrings {
+		lenA, lenB := utf8.RuneCountInString(a), utf8.RuneCountInString(b)
+		first, _ := utf8.DecodeRuneInString(a)
+
+		if minLen := mathutil.Min(lenA, lenB); minLen > 4 &&
+			matches > lenPrefix+1 && 2*matches >= minLen+lenPrefix &&
+			!unicode.IsDigit(first) {
+			similarity += (1.0 - similarity) * float64(matches-lenPrefix-1) /
+				float64(lenA+lenB-2*lenPrefix+2)
+		}
+	}
+
+	return similarity
 }
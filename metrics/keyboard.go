@@ -0,0 +1,174 @@
+package metrics
+
+import (
+	"math"
+	"unicode"
+)
+
+// KeyboardLayout represents the physical arrangement of the keys of a
+// keyboard. It is used by the Keyboard substitution function in order to
+// determine which keys are adjacent.
+type KeyboardLayout struct {
+	keys map[rune]keyPosition
+}
+
+type keyPosition struct {
+	x, y float64
+}
+
+// Predefined keyboard layouts. Only the characters which can be typed
+// without modifier keys are included in the layouts. Upper case letters are
+// mapped to the keys of the corresponding lower case letters.
+var (
+	// QWERTY represents the US QWERTY keyboard layout.
+	QWERTY = NewKeyboardLayout([]string{
+		"`1234567890-=",
+		"qwertyuiop[]\\",
+		"asdfghjkl;'",
+		"zxcvbnm,./",
+	}, nil)
+
+	// AZERTY represents the French AZERTY keyboard layout.
+	AZERTY = NewKeyboardLayout([]string{
+		"²&é\"'(-è_çà)=",
+		"azertyuiop^$",
+		"qsdfghjklmù*",
+		"<wxcvbn,;:!",
+	}, []float64{0, 1.5, 1.75, 1.25})
+
+	// QWERTZ represents the German QWERTZ keyboard layout.
+	QWERTZ = NewKeyboardLayout([]string{
+		"^1234567890ß´",
+		"qwertzuiopü+",
+		"asdfghjklöä#",
+		"<yxcvbnm,.-",
+	}, []float64{0, 1.5, 1.75, 1.25})
+
+	// Dvorak represents the US Dvorak keyboard layout.
+	Dvorak = NewKeyboardLayout([]string{
+		"`1234567890[]",
+		"',.pyfgcrl/=\\",
+		"aoeuidhtns-",
+		";qjkxbmwvz",
+	}, nil)
+)
+
+// NewKeyboardLayout returns a new keyboard layout, created from the specified
+// grid of keys. Each row of the grid is a string containing the characters of
+// the keys on a keyboard row, from left to right, starting with the top row.
+// The offsets specify the horizontal distance (in key widths) between the left
+// edge of the keyboard and the first key of each row. If no offset is provided
+// for a row, the offsets of a standard ANSI keyboard are used (0, 1.5, 1.75
+// and 2.25 for the first four rows and 2.25 for any additional rows).
+func NewKeyboardLayout(rows []string, offsets []float64) *KeyboardLayout {
+	defaultOffsets := []float64{0, 1.5, 1.75, 2.25}
+
+	keys := map[rune]keyPosition{}
+	for y, row := range rows {
+		var offset float64
+		switch {
+		case y < len(offsets):
+			offset = offsets[y]
+		case y < len(defaultOffsets):
+			offset = defaultOffsets[y]
+		default:
+			offset = defaultOffsets[len(defaultOffsets)-1]
+		}
+
+		var x int
+		for _, r := range row {
+			keys[unicode.ToLower(r)] = keyPosition{
+				x: offset + float64(x),
+				y: float64(y),
+			}
+			x++
+		}
+	}
+
+	return &KeyboardLayout{keys: keys}
+}
+
+// Adjacent returns true if the keys of the specified characters are adjacent
+// on the keyboard layout, or false otherwise. Characters which are typed
+// using the same key (e.g. "a" and "A") are also considered adjacent.
+// Characters which are not part of the layout are not adjacent to any key.
+func (l *KeyboardLayout) Adjacent(a, b rune) bool {
+	posA, okA := l.keys[unicode.ToLower(a)]
+	posB, okB := l.keys[unicode.ToLower(b)]
+	if !okA || !okB {
+		return false
+	}
+
+	// Keys on neighbouring rows of a staggered keyboard are at most 1.25 key
+	// widths apart, measured from key center to key center.
+	return math.Hypot(posA.x-posB.x, posA.y-posB.y) <= 1.25
+}
+
+// Keyboard represents a substitution function which scores characters based
+// on the proximity of their keys on a keyboard layout. Substitutions between
+// characters typed using adjacent keys are considered near matches, which
+// makes the function suitable for scoring typing errors.
+type Keyboard struct {
+	// Layout represents the keyboard layout used to determine if the keys
+	// of two characters are adjacent. The QWERTY layout is used if no layout
+	// is specified.
+	Layout *KeyboardLayout
+
+	// Match represents the score of equal character substitutions.
+	Match float64
+
+	// Adjacent represents the score of substitutions between unequal
+	// characters typed using adjacent keys or the same key.
+	Adjacent float64
+
+	// Mismatch represents the score of all other character substitutions.
+	Mismatch float64
+}
+
+// NewKeyboard returns a new keyboard proximity substitution function, which
+// uses the specified keyboard layout. The QWERTY layout is used if the
+// provided layout is nil.
+//
+// Default options:
+//
+//	Match: 1
+//	Adjacent: -0.5
+//	Mismatch: -2
+func NewKeyboard(layout *KeyboardLayout) *Keyboard {
+	return &Keyboard{
+		Layout:   layout,
+		Match:    1,
+		Adjacent: -0.5,
+		Mismatch: -2,
+	}
+}
+
+// Compare returns the match value if a[idxA] is equal to b[idxB], the adjacent
+// value if the characters are typed using adjacent keys or the mismatch value
+// otherwise.
+func (m *Keyboard) Compare(a []rune, idxA int, b []rune, idxB int) float64 {
+	ra, rb := a[idxA], b[idxB]
+	if ra == rb {
+		return m.Match
+	}
+
+	layout := m.Layout
+	if layout == nil {
+		layout = QWERTY
+	}
+	if layout.Adjacent(ra, rb) {
+		return m.Adjacent
+	}
+
+	return m.Mismatch
+}
+
+// Max returns the match value.
+func (m *Keyboard) Max() float64 {
+	return m.Match
+}
+
+// Min returns the mismatch value.
+func (m *Keyboard) Min() float64 {
+	return m.Mismatch
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// LCS represents the longest common subsequence metric for measuring the
+// similarity between sequences. The distance between two sequences is the
+// minimum number of character insertions and deletions required to transform
+// one sequence into the other (also known as the Indel distance). Character
+// substitutions are not allowed.
+//
+// For more information see https://en.wikipedia.org/wiki/Longest_common_subsequence_problem.
+type LCS struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+}
+
+// NewLCS returns a new longest common subsequence string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+func NewLCS() *LCS {
+	return &LCS{
+		CaseSensitive: true,
+	}
+}
+
+// Compare returns the longest common subsequence similarity of a and b.
+// The similarity is calculated as twice the length of the longest common
+// subsequence divided by the total length of the terms. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *LCS) Compare(a, b string) float64 {
+	distance, totalLen := m.distance(a, b)
+	if totalLen == 0 {
+		return 1
+	}
+
+	return 1 - float64(distance)/float64(totalLen)
+}
+
+// Distance returns the longest common subsequence distance between a and b,
+// which is the total length of the terms minus twice the length of their
+// longest common subsequence. Lower distances indicate closer matches.
+// A distance of 0 means the strings are identical.
+func (m *LCS) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *LCS) distance(a, b string) (int, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if one of the terms is empty.
+	lenA, lenB := len(runesA), len(runesB)
+	if lenA == 0 || lenB == 0 {
+		return lenA + lenB, lenA + lenB
+	}
+
+	// Calculate the length of the longest common subsequence.
+	prevCol := make([]int, lenB+1)
+	col := make([]int, lenB+1)
+	for i := 0; i < lenA; i++ {
+		for j := 0; j < lenB; j++ {
+			if runesA[i] == runesB[j] {
+				col[j+1] = prevCol[j] + 1
+			} else {
+				col[j+1] = mathutil.Max(prevCol[j+1], col[j])
+			}
+		}
+
+		col, prevCol = prevCol, col
+	}
+
+	return lenA + lenB - 2*prevCol[lenB], lenA + lenB
+}
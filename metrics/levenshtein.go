@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"math"
 	"strings"
 
 	"github.com/adrg/strutil/internal/mathutil"
@@ -22,6 +23,20 @@ type Levenshtein struct {
 
 	// InsertCost represents the Levenshtein cost of a character substitution.
 	ReplaceCost int
+
+	// Substitution represents an optional substitution function which is
+	// used to calculate the cost of character substitutions. If specified,
+	// the cost of replacing a character with another one is the replacement
+	// cost scaled by the substitution score of the characters, relative to
+	// the score range of the substitution function. Substitutions of
+	// characters with the maximum score cost nothing, while substitutions of
+	// characters with the minimum score cost the full replacement cost. This
+	// allows similar characters (e.g. "0" and "O") to be cheaper to replace.
+	// If the substitution function implements the SequenceSubstitution
+	// interface, substitutions of character sequences of different lengths
+	// (e.g. "rn" and "m") are also taken into account.
+	// Use the Distancef method in order to obtain fractional distances.
+	Substitution Substitution
 }
 
 // NewLevenshtein returns a new Levenshtein string metric.
@@ -32,6 +47,7 @@ type Levenshtein struct {
 //	InsertCost: 1
 //	DeleteCost: 1
 //	ReplaceCost: 1
+//	Substitution: nil
 func NewLevenshtein() *Levenshtein {
 	return &Levenshtein{
 		CaseSensitive: true,
@@ -46,17 +62,32 @@ func NewLevenshtein() *Levenshtein {
 // closer matches.
 func (m *Levenshtein) Compare(a, b string) float64 {
 	distance, maxLen := m.distance(a, b)
-	return 1 - float64(distance)/float64(maxLen)
+	if maxLen == 0 {
+		return 1
+	}
+
+	return 1 - distance/float64(maxLen)
 }
 
 // Distance returns the Levenshtein distance between a and b. Lower distances
 // indicate closer matches. A distance of 0 means the strings are identical.
+// If a substitution function is specified, the distance is rounded to the
+// nearest integer.
 func (m *Levenshtein) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return int(math.Round(distance))
+}
+
+// Distancef returns the Levenshtein distance between a and b as a floating
+// point number. Unlike Distance, the returned distance is not rounded, which
+// is relevant if a substitution function is specified. Lower distances
+// indicate closer matches. A distance of 0 means the strings are identical.
+func (m *Levenshtein) Distancef(a, b string) float64 {
 	distance, _ := m.distance(a, b)
 	return distance
 }
 
-func (m *Levenshtein) distance(a, b string) (int, int) {
+func (m *Levenshtein) distance(a, b string) (float64, int) {
 	// Lower terms if case insensitive comparison is specified.
 	if !m.CaseSensitive {
 		a = strings.ToLower(a)
@@ -73,32 +104,36 @@ func (m *Levenshtein) distance(a, b string) (int, int) {
 	// Check if one of the terms is empty.
 	maxLen := mathutil.Max(lenA, lenB)
 	if lenA == 0 {
-		return m.InsertCost * lenB, maxLen
+		return float64(m.InsertCost * lenB), maxLen
 	}
 	if lenB == 0 {
-		return m.DeleteCost * lenA, maxLen
+		return float64(m.DeleteCost * lenA), maxLen
+	}
+
+	// Use sequence substitutions, if supported by the substitution function.
+	if subst, ok := m.Substitution.(SequenceSubstitution); ok {
+		if pairs := sequencePairs(subst); len(pairs) > 0 {
+			return m.sequenceDistance(runesA, runesB, pairs), maxLen
+		}
 	}
 
 	// Initialize cost slice.
-	prevCol := make([]int, lenB+1)
+	insertCost, deleteCost := float64(m.InsertCost), float64(m.DeleteCost)
+	prevCol := make([]float64, lenB+1)
 	for i := 0; i <= lenB; i++ {
-		prevCol[i] = i
+		prevCol[i] = float64(i) * insertCost
 	}
 
 	// Calculate distance.
-	col := make([]int, lenB+1)
+	col := make([]float64, lenB+1)
 	for i := 0; i < lenA; i++ {
-		col[0] = i + 1
+		col[0] = float64(i+1) * deleteCost
 		for j := 0; j < lenB; j++ {
-			delCost := prevCol[j+1] + m.DeleteCost
-			insCost := col[j] + m.InsertCost
-
-			subCost := prevCol[j]
-			if runesA[i] != runesB[j] {
-				subCost += m.ReplaceCost
-			}
+			delCost := prevCol[j+1] + deleteCost
+			insCost := col[j] + insertCost
+			subCost := prevCol[j] + replaceCost(m.Substitution, float64(m.ReplaceCost), runesA, i, runesB, j)
 
-			col[j+1] = mathutil.Min(delCost, insCost, subCost)
+			col[j+1] = mathutil.Minf(delCost, insCost, subCost)
 		}
 
 		col, prevCol = prevCol, col
@@ -106,3 +141,39 @@ func (m *Levenshtein) distance(a, b string) (int, int) {
 
 	return prevCol[lenB], maxLen
 }
+
+func (m *Levenshtein) sequenceDistance(runesA, runesB []rune, pairs []sequencePair) float64 {
+	lenA, lenB := len(runesA), len(runesB)
+
+	// Initialize cost matrix. As character sequences can span multiple rows
+	// and columns, the whole matrix is needed.
+	insertCost, deleteCost := float64(m.InsertCost), float64(m.DeleteCost)
+	mat := make([][]float64, lenA+1)
+	for i := range mat {
+		mat[i] = make([]float64, lenB+1)
+		mat[i][0] = float64(i) * deleteCost
+	}
+	for j := 0; j <= lenB; j++ {
+		mat[0][j] = float64(j) * insertCost
+	}
+
+	// Calculate distance.
+	for i := 1; i <= lenA; i++ {
+		for j := 1; j <= lenB; j++ {
+			delCost := mat[i-1][j] + deleteCost
+			insCost := mat[i][j-1] + insertCost
+			subCost := mat[i-1][j-1] + replaceCost(m.Substitution, float64(m.ReplaceCost), runesA, i-1, runesB, j-1)
+
+			cost := mathutil.Minf(delCost, insCost, subCost)
+			for _, pair := range pairs {
+				if hasSuffixAt(runesA, i, pair.a) && hasSuffixAt(runesB, j, pair.b) {
+					seqCost := scoreCost(m.Substitution, float64(m.ReplaceCost), pair.score)
+					cost = mathutil.Minf(cost, mat[i-len(pair.a)][j-len(pair.b)]+seqCost)
+				}
+			}
+			mat[i][j] = cost
+		}
+	}
+
+	return mat[lenA][lenB]
+}
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/adrg/strutil/internal/stringutil"
+)
+
+// LongestCommonSubstring represents the longest common substring metric for
+// measuring the similarity between sequences. The similarity is based on the
+// length of the longest contiguous sequence of characters shared by the
+// compared sequences.
+//
+// For more information see https://en.wikipedia.org/wiki/Longest_common_substring.
+type LongestCommonSubstring struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+}
+
+// NewLongestCommonSubstring returns a new longest common substring
+// string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+func NewLongestCommonSubstring() *LongestCommonSubstring {
+	return &LongestCommonSubstring{
+		CaseSensitive: true,
+	}
+}
+
+// Compare returns the longest common substring similarity of a and b.
+// The similarity is calculated as twice the length of the longest common
+// substring divided by the total length of the terms. The returned similarity
+// is a number between 0 and 1. Larger similarity numbers indicate closer
+// matches.
+func (m *LongestCommonSubstring) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	// Check if both terms are empty.
+	totalLen := utf8.RuneCountInString(a) + utf8.RuneCountInString(b)
+	if totalLen == 0 {
+		return 1
+	}
+
+	// Return similarity.
+	substr, _, _ := stringutil.LongestCommonSubstring(a, b)
+	return 2 * float64(utf8.RuneCountInString(substr)) / float64(totalLen)
+}
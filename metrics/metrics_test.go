@@ -2,6 +2,8 @@ package metrics_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/adrg/strutil/metrics"
@@ -12,6 +14,137 @@ func sf(a float64) string {
 	return fmt.Sprintf("%.2f", a)
 }
 
+type confusables map[[2]rune]float64
+
+func (c confusables) Compare(a []rune, idxA int, b []rune, idxB int) float64 {
+	if a[idxA] == b[idxB] {
+		return 1
+	}
+	if score, ok := c[[2]rune{a[idxA], b[idxB]}]; ok {
+		return score
+	}
+	return c[[2]rune{b[idxB], a[idxA]}]
+}
+
+func (c confusables) Max() float64 {
+	return 1
+}
+
+func (c confusables) Min() float64 {
+	return 0
+}
+
+func TestBagDistance(t *testing.T) {
+	b := metrics.NewBagDistance()
+	require.Equal(t, 0, b.Distance("", ""))
+	require.Equal(t, "1.00", sf(b.Compare("", "")))
+	require.Equal(t, 4, b.Distance("test", ""))
+	require.Equal(t, 4, b.Distance("", "test"))
+	require.Equal(t, 0, b.Distance("listen", "silent"))
+	require.Equal(t, 3, b.Distance("book", "brick"))
+	require.Equal(t, 3, b.Distance("kitten", "sitting"))
+	require.Equal(t, 1, b.Distance("ab\u2019d", "ab\u2019c"))
+	require.Equal(t, "0.75", sf(b.Compare("ab\u2018c", "ab\u2019c")))
+	require.Equal(t, "0.40", sf(b.Compare("book", "brick")))
+	require.Equal(t, "1.00", sf(b.Compare("listen", "silent")))
+	b.CaseSensitive = false
+	require.Equal(t, 0, b.Distance("LISTEN", "silent"))
+}
+
+func TestCosine(t *testing.T) {
+	c := metrics.NewCosine()
+	require.Equal(t, "1.00", sf(c.Compare("", "")))
+	require.Equal(t, "0.00", sf(c.Compare("a", "b")))
+	require.Equal(t, "0.00", sf(c.Compare("test", "")))
+	require.Equal(t, "1.00", sf(c.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, "0.25", sf(c.Compare("night", "nacht")))
+	require.Equal(t, "1.00", sf(c.Compare("aaaa", "aa")))
+	require.Equal(t, "0.89", sf(c.Compare("abab", "ab")))
+	require.Equal(t, "0.80", sf(c.Compare("ababab", "abba")))
+	require.Equal(t, "0.68", sf(c.Compare("time to make haste", "no time to waste")))
+	c.CaseSensitive = false
+	c.NgramSize = 3
+	require.Equal(t, "0.53", sf(c.Compare("Time to make haste", "no time to waste")))
+	c.NgramSize = 0
+	require.Equal(t, "0.25", sf(c.Compare("NIGHT", "nacht")))
+	c.Tokenizer = metrics.NewWhitespaceTokenizer()
+	require.Equal(t, "0.80", sf(c.Compare("a a b", "a b b")))
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	d := metrics.NewDamerauLevenshtein()
+	require.Equal(t, 0, d.Distance("", ""))
+	require.Equal(t, "1.00", sf(d.Compare("", "")))
+	require.Equal(t, 4, d.Distance("test", ""))
+	require.Equal(t, 4, d.Distance("", "test"))
+	require.Equal(t, 1, d.Distance("hte", "the"))
+	require.Equal(t, 1, d.Distance("ab\u2019c", "a\u2019bc"))
+	require.Equal(t, 3, d.Distance("ca", "abc"))
+	require.Equal(t, 3, d.Distance("book", "brick"))
+	require.Equal(t, "0.67", sf(d.Compare("hte", "the")))
+	d.CaseSensitive = false
+	require.Equal(t, 1, d.Distance("HTE", "the"))
+	d.TransposeCost = 2
+	require.Equal(t, 2, d.Distance("hte", "the"))
+	d.InsertCost = 2
+	d.DeleteCost = 3
+	require.Equal(t, 12, d.Distance("test", ""))
+	require.Equal(t, 8, d.Distance("", "test"))
+
+	// Unrestricted Damerau-Levenshtein distance.
+	d = metrics.NewDamerauLevenshtein()
+	d.Unrestricted = true
+	require.Equal(t, 0, d.Distance("", ""))
+	require.Equal(t, 4, d.Distance("test", ""))
+	require.Equal(t, 4, d.Distance("", "test"))
+	require.Equal(t, 1, d.Distance("hte", "the"))
+	require.Equal(t, 2, d.Distance("ca", "abc"))
+	require.Equal(t, 3, d.Distance("book", "brick"))
+	require.Equal(t, 2, d.Distance("a cat", "an act"))
+	require.Equal(t, 1, d.Distance("ab\u2019c", "a\u2019bc"))
+	require.Equal(t, "0.33", sf(d.Compare("ca", "abc")))
+	d.CaseSensitive = false
+	require.Equal(t, 2, d.Distance("CA", "abc"))
+	d.TransposeCost = 2
+	d.ReplaceCost = 2
+	require.Equal(t, 3, d.Distance("ca", "abc"))
+
+	// Custom substitution costs.
+	for _, unrestricted := range []bool{false, true} {
+		d = metrics.NewDamerauLevenshtein()
+		d.Unrestricted = unrestricted
+		d.Substitution = confusables{{'0', 'O'}: 0.9, {'1', 'l'}: 0.8}
+		require.Equal(t, "0.00", sf(d.Distancef("", "")))
+		require.Equal(t, "0.10", sf(d.Distancef("SN-100", "SN-10O")))
+		require.Equal(t, "1.30", sf(d.Distancef("SN-100", "NS-l0O")))
+		require.Equal(t, 1, d.Distance("SN-100", "NS-l0O"))
+		require.Equal(t, "0.98", sf(d.Compare("SN-100", "SN-10O")))
+	}
+}
+
+func TestEditex(t *testing.T) {
+	e := metrics.NewEditex()
+	require.Equal(t, 0, e.Distance("", ""))
+	require.Equal(t, "1.00", sf(e.Compare("", "")))
+	require.Equal(t, 4, e.Distance("ab", ""))
+	require.Equal(t, 4, e.Distance("", "ab"))
+	require.Equal(t, "0.00", sf(e.Compare("ab", "")))
+	require.Equal(t, 2, e.Distance("cat", "hat"))
+	require.Equal(t, 2, e.Distance("Niall", "Neil"))
+	require.Equal(t, 1, e.Distance("smith", "smyth"))
+	require.Equal(t, 1, e.Distance("Ashcraft", "Ashcroft"))
+	require.Equal(t, 0, e.Distance("ab\u2019c", "ab\u2019c"))
+	require.Equal(t, 2, e.Distance("ab\u2019d", "ab\u2019c"))
+	require.Equal(t, 1, e.Distance("ab\u2019d", "ab\u2019t"))
+	require.Equal(t, "0.67", sf(e.Compare("cat", "hat")))
+	require.Equal(t, "0.80", sf(e.Compare("Niall", "Neil")))
+	require.Equal(t, 8, e.Distance("ATCG", "tagc"))
+	e.CaseSensitive = false
+	require.Equal(t, 12, e.Distance("aluminum", "Catalan"))
+	require.Equal(t, 6, e.Distance("ATCG", "tagc"))
+	require.Equal(t, "0.25", sf(e.Compare("ATCG", "tagc")))
+}
+
 func TestHamming(t *testing.T) {
 	h := metrics.NewHamming()
 	require.Equal(t, 0, h.Distance("", ""))
@@ -24,6 +157,44 @@ func TestHamming(t *testing.T) {
 	require.Equal(t, "0.50", sf(h.Compare("one", "ONCE")))
 }
 
+func TestHammingBytes(t *testing.T) {
+	distance, sim := metrics.HammingBytes(nil, []byte{})
+	require.Equal(t, 0, distance)
+	require.Equal(t, "1.00", sf(sim))
+
+	distance, sim = metrics.HammingBytes([]byte{0x0f}, []byte{0xff})
+	require.Equal(t, 4, distance)
+	require.Equal(t, "0.50", sf(sim))
+
+	distance, sim = metrics.HammingBytes([]byte{0xfe, 0x01}, []byte{0xff})
+	require.Equal(t, 9, distance)
+	require.Equal(t, "0.44", sf(sim))
+
+	distance, sim = metrics.HammingBytes([]byte("strutil metrics"), []byte("strutil metrics"))
+	require.Equal(t, 0, distance)
+	require.Equal(t, "1.00", sf(sim))
+
+	a := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	b := []byte{1, 1, 2, 3, 4, 5, 6, 7, 8, 8}
+	distance, sim = metrics.HammingBytes(a, b)
+	require.Equal(t, 2, distance)
+	require.Equal(t, "0.97", sf(sim))
+}
+
+func TestHammingUint64(t *testing.T) {
+	distance, sim := metrics.HammingUint64(0, 0)
+	require.Equal(t, 0, distance)
+	require.Equal(t, "1.00", sf(sim))
+
+	distance, sim = metrics.HammingUint64(0xf0f0, 0x0ff0)
+	require.Equal(t, 8, distance)
+	require.Equal(t, "0.88", sf(sim))
+
+	distance, sim = metrics.HammingUint64(0, ^uint64(0))
+	require.Equal(t, 64, distance)
+	require.Equal(t, "0.00", sf(sim))
+}
+
 func TestJaccard(t *testing.T) {
 	j := metrics.NewJaccard()
 	require.Equal(t, "1.00", sf(j.Compare("", "")))
@@ -37,6 +208,11 @@ func TestJaccard(t *testing.T) {
 	j.CaseSensitive = false
 	j.NgramSize = 3
 	require.Equal(t, "0.33", sf(j.Compare("NIGHT", "alright")))
+	j.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "0.60", sf(j.Compare("Red cotton shirt, large", "large shirt cotton blue")))
+	require.Equal(t, "0.00", sf(j.Compare("night", "alright")))
+	j.Tokenizer = metrics.NewNgramTokenizer(2)
+	require.Equal(t, "0.43", sf(j.Compare("night", "alright")))
 }
 
 func TestJaro(t *testing.T) {
@@ -51,6 +227,14 @@ func TestJaro(t *testing.T) {
 	require.Equal(t, "0.64", sf(j.Compare("sort", "report")))
 	j.CaseSensitive = false
 	require.Equal(t, "0.78", sf(j.Compare("sort", "SHIRT")))
+	j.CaseSensitive = true
+	require.Equal(t, "0.39", sf(j.Compare("abcdef", "fedcba")))
+	require.Equal(t, "0.96", sf(j.Compare("algorithm", "logarithm")))
+	j.MatchWindow = 1
+	require.Equal(t, "0.39", sf(j.Compare("abcdef", "fedcba")))
+	require.Equal(t, "0.85", sf(j.Compare("algorithm", "logarithm")))
+	j.MatchWindow = 10
+	require.Equal(t, "0.83", sf(j.Compare("abcdef", "fedcba")))
 }
 
 func TestJaroWinkler(t *testing.T) {
@@ -64,6 +248,154 @@ func TestJaroWinkler(t *testing.T) {
 	require.Equal(t, "0.94", sf(j.Compare("charm", "charmed")))
 	j.CaseSensitive = false
 	require.Equal(t, "0.80", sf(j.Compare("sort", "SHIRT")))
+	j.CaseSensitive = true
+	require.Equal(t, "0.81", sf(j.Compare("dixon", "dicksonx")))
+	require.Equal(t, "0.96", sf(j.Compare("martha", "marhta")))
+
+	// Long strings adjustment.
+	j.LongStrings = true
+	require.Equal(t, "0.83", sf(j.Compare("dixon", "dicksonx")))
+	require.Equal(t, "0.97", sf(j.Compare("martha", "marhta")))
+	require.Equal(t, "0.94", sf(j.Compare("charm", "charmed")))
+	require.Equal(t, "0.96", sf(j.Compare("xabcdefg", "xabcdefh")))
+	require.Equal(t, "0.95", sf(j.Compare("1abcdefg", "1abcdefh")))
+
+	// Prefix parameters.
+	j.LongStrings = false
+	j.PrefixScale = 0.2
+	j.MaxPrefixLength = 2
+	require.Equal(t, "0.86", sf(j.Compare("dixon", "dicksonx")))
+	require.Equal(t, "0.97", sf(j.Compare("martha", "marhta")))
+	j.PrefixScale = -1
+	j.MaxPrefixLength = 0
+	require.Equal(t, "0.81", sf(j.Compare("dixon", "dicksonx")))
+	j.PrefixScale = 0
+	require.Equal(t, "0.77", sf(j.Compare("dixon", "dicksonx")))
+	require.Equal(t, "0.94", sf(j.Compare("martha", "marhta")))
+	j.PrefixScale = 0.3
+	require.Equal(t, 1.0, j.Compare("abcdx", "abcdy"))
+	j.LongStrings = true
+	require.Equal(t, 1.0, j.Compare("abcdefgx", "abcdefgy"))
+	j.LongStrings = false
+	j.PrefixScale = -1
+
+	// Match window.
+	j.MatchWindow = 1
+	require.Equal(t, "0.85", sf(j.Compare("algorithm", "logarithm")))
+}
+
+func TestKeyboardLayout(t *testing.T) {
+	require.True(t, metrics.QWERTY.Adjacent('a', 'q'))
+	require.True(t, metrics.QWERTY.Adjacent('a', 'W'))
+	require.True(t, metrics.QWERTY.Adjacent('a', 'A'))
+	require.True(t, metrics.QWERTY.Adjacent('g', 'b'))
+	require.True(t, metrics.QWERTY.Adjacent('5', 't'))
+	require.False(t, metrics.QWERTY.Adjacent('a', 'x'))
+	require.False(t, metrics.QWERTY.Adjacent('a', 'e'))
+	require.False(t, metrics.QWERTY.Adjacent('a', '\u2019'))
+	require.True(t, metrics.AZERTY.Adjacent('a', 'z'))
+	require.True(t, metrics.AZERTY.Adjacent('m', '\u00f9'))
+	require.False(t, metrics.AZERTY.Adjacent('a', 's'))
+	require.True(t, metrics.QWERTZ.Adjacent('z', 't'))
+	require.True(t, metrics.QWERTZ.Adjacent('y', 'a'))
+	require.False(t, metrics.QWERTZ.Adjacent('z', 'x'))
+	require.True(t, metrics.Dvorak.Adjacent('a', 'o'))
+	require.False(t, metrics.Dvorak.Adjacent('a', 's'))
+
+	// Number row keys.
+	for _, layout := range []struct {
+		layout      *metrics.KeyboardLayout
+		adjacent    [][2]rune
+		nonAdjacent [][2]rune
+	}{
+		{
+			layout:      metrics.QWERTY,
+			adjacent:    [][2]rune{{'1', 'q'}, {'2', 'q'}, {'4', 'e'}, {'0', 'p'}, {'-', 'p'}},
+			nonAdjacent: [][2]rune{{'`', 'q'}, {'3', 'q'}, {'4', 't'}, {'9', '['}, {'=', 'p'}},
+		},
+		{
+			layout:      metrics.AZERTY,
+			adjacent:    [][2]rune{{'&', 'a'}, {'\u00e9', 'a'}, {'"', 'e'}, {'\u00e0', 'p'}, {')', 'p'}},
+			nonAdjacent: [][2]rune{{'\u00b2', 'a'}, {'"', 'a'}, {'\'', 't'}, {'\u00e7', '^'}, {'=', 'p'}},
+		},
+		{
+			layout:      metrics.QWERTZ,
+			adjacent:    [][2]rune{{'1', 'q'}, {'2', 'q'}, {'4', 'e'}, {'0', 'p'}, {'\u00df', 'p'}},
+			nonAdjacent: [][2]rune{{'^', 'q'}, {'3', 'q'}, {'4', 't'}, {'9', '\u00fc'}, {'\u00b4', 'p'}},
+		},
+		{
+			layout:      metrics.Dvorak,
+			adjacent:    [][2]rune{{'1', '\''}, {'2', '\''}, {'4', '.'}, {'0', 'l'}, {'[', 'l'}},
+			nonAdjacent: [][2]rune{{'`', '\''}, {'3', '\''}, {'4', 'y'}, {'9', '/'}, {']', 'l'}},
+		},
+	} {
+		for _, keys := range layout.adjacent {
+			require.True(t, layout.layout.Adjacent(keys[0], keys[1]), string(keys[:]))
+		}
+		for _, keys := range layout.nonAdjacent {
+			require.False(t, layout.layout.Adjacent(keys[0], keys[1]), string(keys[:]))
+		}
+	}
+
+	l := metrics.NewKeyboardLayout([]string{"abc", "def"}, []float64{0, 2})
+	require.True(t, l.Adjacent('a', 'b'))
+	require.True(t, l.Adjacent('c', 'd'))
+	require.False(t, l.Adjacent('a', 'd'))
+	require.False(t, l.Adjacent('a', 'c'))
+}
+
+func TestKeyboard(t *testing.T) {
+	k := metrics.NewKeyboard(nil)
+	require.Equal(t, "1.00", sf(k.Compare([]rune("a"), 0, []rune("a"), 0)))
+	require.Equal(t, "-0.50", sf(k.Compare([]rune("a"), 0, []rune("s"), 0)))
+	require.Equal(t, "-0.50", sf(k.Compare([]rune("a"), 0, []rune("A"), 0)))
+	require.Equal(t, "-2.00", sf(k.Compare([]rune("a"), 0, []rune("p"), 0)))
+	require.Equal(t, "1.00", sf(k.Max()))
+	require.Equal(t, "-2.00", sf(k.Min()))
+	k.Layout = metrics.AZERTY
+	require.Equal(t, "-2.00", sf(k.Compare([]rune("a"), 0, []rune("s"), 0)))
+	require.Equal(t, "-0.50", sf(k.Compare([]rune("a"), 0, []rune("z"), 0)))
+
+	s := metrics.NewSmithWatermanGotoh()
+	s.Substitution = metrics.NewKeyboard(metrics.QWERTY)
+	require.Equal(t, "0.73", sf(s.Compare("hello world", "hrllo wprld")))
+
+	l := metrics.NewLevenshtein()
+	l.Substitution = metrics.NewKeyboard(metrics.QWERTY)
+	require.Equal(t, "0.50", sf(l.Distancef("hello", "hrllo")))
+	require.Equal(t, "1.00", sf(l.Distancef("hello", "hxllo")))
+}
+
+func TestLCS(t *testing.T) {
+	l := metrics.NewLCS()
+	require.Equal(t, 0, l.Distance("", ""))
+	require.Equal(t, "1.00", sf(l.Compare("", "")))
+	require.Equal(t, 4, l.Distance("test", ""))
+	require.Equal(t, 4, l.Distance("", "test"))
+	require.Equal(t, "0.00", sf(l.Compare("test", "")))
+	require.Equal(t, 0, l.Distance("ab\u2019c", "ab\u2019c"))
+	require.Equal(t, 2, l.Distance("ab\u2019d", "ab\u2019c"))
+	require.Equal(t, 2, l.Distance("ab\u2018c", "ab\u2019c"))
+	require.Equal(t, 5, l.Distance("book", "brick"))
+	require.Equal(t, "0.44", sf(l.Compare("book", "brick")))
+	require.Equal(t, "0.75", sf(l.Compare("ab\u2019d", "ab\u2019c")))
+	require.Equal(t, "0.67", sf(l.Compare("abc", "bca")))
+	l.CaseSensitive = false
+	require.Equal(t, "0.80", sf(l.Compare("hello", "JELLO")))
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	l := metrics.NewLongestCommonSubstring()
+	require.Equal(t, "1.00", sf(l.Compare("", "")))
+	require.Equal(t, "0.00", sf(l.Compare("test", "")))
+	require.Equal(t, "0.00", sf(l.Compare("", "test")))
+	require.Equal(t, "0.00", sf(l.Compare("a", "b")))
+	require.Equal(t, "1.00", sf(l.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, "0.75", sf(l.Compare("ab\u2019d", "ab\u2019c")))
+	require.Equal(t, "0.50", sf(l.Compare("ab\u2018c", "ab\u2019c")))
+	require.Equal(t, "0.57", sf(l.Compare("abcdxyz", "xyzabcd")))
+	l.CaseSensitive = false
+	require.Equal(t, "0.57", sf(l.Compare("ABCDxyz", "xyzabcd")))
 }
 
 func TestLevenshtein(t *testing.T) {
@@ -71,6 +403,8 @@ func TestLevenshtein(t *testing.T) {
 	require.Equal(t, 0, l.Distance("", ""))
 	require.Equal(t, 4, l.Distance("test", ""))
 	require.Equal(t, 4, l.Distance("", "test"))
+	require.Equal(t, "1.00", sf(l.Compare("", "")))
+	require.Equal(t, "0.00", sf(l.Compare("", "test")))
 	require.Equal(t, 0, l.Distance("ab\u2019c", "ab\u2019c"))
 	require.Equal(t, 1, l.Distance("ab\u2019d", "ab\u2019c"))
 	require.Equal(t, 1, l.Distance("ab\u2018c", "ab\u2019c"))
@@ -84,6 +418,117 @@ func TestLevenshtein(t *testing.T) {
 	require.Equal(t, "1.00", sf(l.Compare("ab\u2019c", "ab\u2019c")))
 	require.Equal(t, "0.50", sf(l.Compare("ab\u2019d", "ab\u2019c")))
 	require.Equal(t, "0.50", sf(l.Compare("ab\u2018c", "ab\u2019c")))
+	l.InsertCost, l.DeleteCost = 3, 3
+	require.Equal(t, 3, l.Distance("ab", "b"))
+	require.Equal(t, 3, l.Distance("b", "ab"))
+	require.Equal(t, 3, l.Distance("abc", "ac"))
+
+	// Custom substitution costs.
+	l = metrics.NewLevenshtein()
+	l.Substitution = confusables{{'0', 'O'}: 0.9, {'1', 'l'}: 0.8}
+	require.Equal(t, 0, l.Distance("", ""))
+	require.Equal(t, 4, l.Distance("test", ""))
+	require.Equal(t, "0.10", sf(l.Distancef("SN-100", "SN-10O")))
+	require.Equal(t, "0.30", sf(l.Distancef("SN-100", "SN-l0O")))
+	require.Equal(t, "1.10", sf(l.Distancef("SN-100", "SN-20O")))
+	require.Equal(t, 1, l.Distance("SN-100", "SN-20O"))
+	require.Equal(t, "0.98", sf(l.Compare("SN-100", "SN-10O")))
+	l.ReplaceCost = 2
+	require.Equal(t, "0.20", sf(l.Distancef("SN-100", "SN-10O")))
+	require.Equal(t, "2.00", sf(l.Distancef("SN-100", "SN-102")))
+	l.Substitution = metrics.MatchMismatch{
+		Match:    1,
+		Mismatch: 1,
+	}
+	require.Equal(t, "2.00", sf(l.Distancef("SN-100", "SN-10O")))
+}
+
+func TestMongeElkan(t *testing.T) {
+	m := metrics.NewMongeElkan()
+	require.Equal(t, "1.00", sf(m.Compare("", "")))
+	require.Equal(t, "1.00", sf(m.Compare(" ", "")))
+	require.Equal(t, "0.00", sf(m.Compare("test", "")))
+	require.Equal(t, "0.00", sf(m.Compare("", "test")))
+	require.Equal(t, "1.00", sf(m.Compare("ab\u2019c d", "d ab\u2019c")))
+	require.Equal(t, "0.67", sf(m.Compare("Smith John A", "John Smith")))
+	require.Equal(t, "1.00", sf(m.Compare("John Smith", "Smith John A")))
+	require.Equal(t, "0.91", sf(m.Compare("Jon Smyth", "John Smith")))
+	require.Equal(t, "0.94", sf(m.Compare("paul johnson", "johson paule")))
+
+	// Symmetric variant.
+	m.Symmetric = true
+	require.Equal(t, "0.83", sf(m.Compare("Smith John A", "John Smith")))
+	require.Equal(t, "0.83", sf(m.Compare("John Smith", "Smith John A")))
+
+	// Custom inner metric.
+	m.Symmetric = false
+	m.Metric = metrics.NewLevenshtein()
+	require.Equal(t, "0.78", sf(m.Compare("Jon Smyth", "John Smith")))
+	require.Equal(t, "0.83", sf(m.Compare("paul johnson", "johson paule")))
+	m.Metric = nil
+	require.Equal(t, "0.91", sf(m.Compare("Jon Smyth", "John Smith")))
+}
+
+func TestNeedlemanWunsch(t *testing.T) {
+	n := metrics.NewNeedlemanWunsch()
+	require.Equal(t, "1.00", sf(n.Compare("", "")))
+	require.Equal(t, "0.00", sf(n.Compare("test", "")))
+	require.Equal(t, "0.00", sf(n.Compare("", "test")))
+	require.Equal(t, "0.00", sf(n.Compare("ab", "cd")))
+	require.Equal(t, "0.62", sf(n.Compare("a pink kitten", "a kitten")))
+	require.Equal(t, "0.57", sf(n.Compare("kitten", "sitting")))
+	require.Equal(t, "1.00", sf(n.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, "0.75", sf(n.Compare("ab\u2019d", "ab\u2019c")))
+	require.Equal(t, "0.75", sf(n.Compare("ab\u2018c", "ab\u2019c")))
+	n.Substitution = nil
+	require.Equal(t, "0.62", sf(n.Compare("a pink kitten", "a kitten")))
+	n.CaseSensitive = false
+	n.GapPenalty = -0.5
+	n.Substitution = metrics.MatchMismatch{
+		Match:    1,
+		Mismatch: -2,
+	}
+	require.Equal(t, "0.81", sf(n.Compare("a pink kitten", "A KITTEN")))
+}
+
+func TestOCRConfusion(t *testing.T) {
+	o := metrics.NewOCRConfusion()
+	require.Equal(t, "1.00", sf(o.Compare([]rune("a"), 0, []rune("a"), 0)))
+	require.Equal(t, "0.50", sf(o.Compare([]rune("0"), 0, []rune("O"), 0)))
+	require.Equal(t, "0.50", sf(o.Compare([]rune("O"), 0, []rune("0"), 0)))
+	require.Equal(t, "0.50", sf(o.Compare([]rune("S"), 0, []rune("5"), 0)))
+	require.Equal(t, "0.50", sf(o.Compare([]rune("I"), 0, []rune("l"), 0)))
+	require.Equal(t, "-2.00", sf(o.Compare([]rune("a"), 0, []rune("b"), 0)))
+	require.Equal(t, "-2.00", sf(o.Compare([]rune("r"), 0, []rune("m"), 0)))
+	require.Equal(t, "1.00", sf(o.Max()))
+	require.Equal(t, "-2.00", sf(o.Min()))
+	require.Equal(t, 0.5, o.Sequences()[[2]string{"rn", "m"}])
+	require.NotContains(t, o.Sequences(), [2]string{"0", "O"})
+
+	l := metrics.NewLevenshtein()
+	l.Substitution = o
+	require.Equal(t, "0.00", sf(l.Distancef("", "")))
+	require.Equal(t, "0.00", sf(l.Distancef("modern", "modern")))
+	require.Equal(t, "0.17", sf(l.Distancef("modern", "rnodern")))
+	require.Equal(t, "0.17", sf(l.Distancef("rnodern", "modern")))
+	require.Equal(t, "0.17", sf(l.Distancef("clear", "dear")))
+	require.Equal(t, "0.50", sf(l.Distancef("INV-0015", "INV-OO1S")))
+	require.Equal(t, "0.33", sf(l.Distancef("modern", "rnodem")))
+	require.Equal(t, 0, l.Distance("modern", "rnodern"))
+	require.Equal(t, "0.98", sf(l.Compare("modern", "rnodern")))
+	o.Confusions[[2]string{"m", "rn"}] = 1
+	delete(o.Confusions, [2]string{"rn", "m"})
+	require.Equal(t, "0.00", sf(l.Distancef("modern", "rnodern")))
+	delete(o.Confusions, [2]string{"m", "rn"})
+	require.Equal(t, "2.00", sf(l.Distancef("modern", "rnodern")))
+	l.InsertCost, l.DeleteCost = 2, 2
+	require.Equal(t, "2.00", sf(l.Distancef("ab", "b")))
+	require.Equal(t, "2.00", sf(l.Distancef("b", "ab")))
+	require.Equal(t, "0.50", sf(l.Distancef("INV-0015", "INV-OO1S")))
+
+	s := metrics.NewSmithWatermanGotoh()
+	s.Substitution = metrics.NewOCRConfusion()
+	require.Equal(t, "0.81", sf(s.Compare("INV-0015", "INV-OO1S")))
 }
 
 func TestOperlapCoefficient(t *testing.T) {
@@ -102,6 +547,151 @@ func TestOperlapCoefficient(t *testing.T) {
 	require.Equal(t, "1.00", sf(o.Compare("aa", "AAAA")))
 	o.NgramSize = 3
 	require.Equal(t, "0.67", sf(o.Compare("night", "alright")))
+	o.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "1.00", sf(o.Compare("cotton shirt", "large shirt cotton blue")))
+	o.Tokenizer = metrics.NewWordNgramTokenizer(2)
+	require.Equal(t, "1.00", sf(o.Compare("cotton shirt", "large cotton shirt blue")))
+	require.Equal(t, "0.00", sf(o.Compare("shirt cotton", "large cotton shirt blue")))
+}
+
+func TestPartial(t *testing.T) {
+	p := metrics.NewPartial()
+	require.Equal(t, "1.00", sf(p.Compare("", "")))
+	require.Equal(t, "0.00", sf(p.Compare("a", "")))
+	require.Equal(t, "0.00", sf(p.Compare("", "a")))
+	require.Equal(t, "1.00", sf(p.Compare("ab\u2019c", "xab\u2019cx")))
+	require.Equal(t, "1.00", sf(p.Compare("this is a test", "this is a test!")))
+	require.Equal(t, "0.67", sf(p.Compare("abcd", "cdab")))
+	require.Equal(t, "0.00", sf(p.Compare("amazon", "POS PURCHASE AMAZON MKTPLACE SEATTLE WA")))
+
+	align := p.Align("caf\u00e9", "le caf\u00e9 de flore")
+	require.Equal(t, metrics.PartialAlignment{Score: 1, StartA: 0, EndA: 5, StartB: 3, EndB: 8}, align)
+
+	align = p.Align("abcd", "cdab")
+	require.Equal(t, "0.67", sf(align.Score))
+	require.Equal(t, [4]int{0, 4, 0, 2}, [4]int{align.StartA, align.EndA, align.StartB, align.EndB})
+
+	align = p.Align("xxabc", "abc")
+	require.Equal(t, "1.00", sf(align.Score))
+	require.Equal(t, [4]int{2, 5, 0, 3}, [4]int{align.StartA, align.EndA, align.StartB, align.EndB})
+
+	// Windows overlapping the start and the end of the longer term.
+	align = p.Align("abcd", "cdxxxxab")
+	require.Equal(t, "0.67", sf(align.Score))
+	require.Equal(t, [4]int{0, 4, 0, 2}, [4]int{align.StartA, align.EndA, align.StartB, align.EndB})
+	align = p.Align("abcd", "xxxxabc")
+	require.Equal(t, "0.86", sf(align.Score))
+	require.Equal(t, [4]int{0, 4, 4, 7}, [4]int{align.StartA, align.EndA, align.StartB, align.EndB})
+
+	// Case insensitive comparison.
+	p.CaseSensitive = false
+	align = p.Align("POS 4411 STARBUCKS #1234 NEW YORK", "starbucks")
+	require.Equal(t, metrics.PartialAlignment{Score: 1, StartA: 9, EndA: 18, StartB: 0, EndB: 9}, align)
+	align = p.Align("amazon mktplace", "POS PURCHASE AMAZN MKTPLACE SEATTLE WA")
+	require.Equal(t, "0.93", sf(align.Score))
+	require.Equal(t, [4]int{0, 15, 12, 27}, [4]int{align.StartA, align.EndA, align.StartB, align.EndB})
+
+	// Custom inner metric.
+	p.Metric = metrics.NewLevenshtein()
+	require.Equal(t, "0.87", sf(p.Compare("amazon mktplace", "POS PURCHASE AMAZN MKTPLACE SEATTLE WA")))
+	p.Metric = nil
+	require.Equal(t, "0.93", sf(p.Compare("amazon mktplace", "POS PURCHASE AMAZN MKTPLACE SEATTLE WA")))
+}
+
+func TestQGram(t *testing.T) {
+	q := metrics.NewQGram()
+	require.Equal(t, 0, q.Distance("", ""))
+	require.Equal(t, "1.00", sf(q.Compare("", "")))
+	require.Equal(t, "0.00", sf(q.Compare("a", "b")))
+	require.Equal(t, 0, q.Distance("a", "a"))
+	require.Equal(t, "1.00", sf(q.Compare("a", "a")))
+	require.Equal(t, "0.00", sf(q.Compare("a", "A")))
+	require.Equal(t, 1, q.Distance("ab", ""))
+	require.Equal(t, "0.00", sf(q.Compare("ab", "")))
+	require.Equal(t, "1.00", sf(q.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, 2, q.Distance("ab", "ba"))
+	require.Equal(t, 2, q.Distance("abc", "abd"))
+	require.Equal(t, "0.50", sf(q.Compare("abc", "abd")))
+	require.Equal(t, 6, q.Distance("night", "nacht"))
+	require.Equal(t, "0.25", sf(q.Compare("night", "nacht")))
+	require.Equal(t, 2, q.Distance("aaaa", "aa"))
+	require.Equal(t, "0.50", sf(q.Compare("aaaa", "aa")))
+
+	// Padding.
+	q.Padding = true
+	require.Equal(t, "1.00", sf(q.Compare("", "")))
+	require.Equal(t, 4, q.Distance("a", "b"))
+	require.Equal(t, 3, q.Distance("ab", ""))
+	require.Equal(t, "0.33", sf(q.Compare("ab", "ac")))
+	require.Equal(t, "0.50", sf(q.Compare("abc", "abd")))
+	require.Equal(t, "0.50", sf(q.Compare("night", "nacht")))
+	require.Equal(t, "0.75", sf(q.Compare("aaaa", "aa")))
+
+	// Custom options.
+	q.CaseSensitive = false
+	q.Padding = false
+	require.Equal(t, "1.00", sf(q.Compare("a", "A")))
+	q.Padding = true
+	q.NgramSize = 3
+	require.Equal(t, 8, q.Distance("NIGHT", "nacht"))
+	require.Equal(t, "0.43", sf(q.Compare("NIGHT", "nacht")))
+}
+
+func TestRatcliffObershelp(t *testing.T) {
+	r := metrics.NewRatcliffObershelp()
+	require.Equal(t, "1.00", sf(r.Compare("", "")))
+	require.Equal(t, "0.00", sf(r.Compare("test", "")))
+	require.Equal(t, "0.00", sf(r.Compare("", "test")))
+	require.Equal(t, "0.25", sf(r.Compare("tide", "diet")))
+	require.Equal(t, "0.75", sf(r.Compare("abcd", "bcde")))
+	require.Equal(t, "0.77", sf(r.Compare("private", "privet")))
+	require.Equal(t, "0.78", sf(r.Compare("wikimedia", "wikimania")))
+	require.Equal(t, "1.00", sf(r.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, "0.75", sf(r.Compare("ab\u2019d", "ab\u2019c")))
+	require.Equal(t, "0.71", sf(r.Compare(" abcd", "abcd abcd")))
+
+	a := strings.Repeat("x", 10) + strings.Repeat("y", 200)
+	b := strings.Repeat("xy", 100) + strings.Repeat("y", 50)
+	require.Equal(t, "0.00", sf(r.Compare(a, b)))
+	r.Autojunk = false
+	require.Equal(t, "0.27", sf(r.Compare(a, b)))
+
+	r.IsJunk = func(r rune) bool { return r == ' ' }
+	require.Equal(t, "0.57", sf(r.Compare(" abcd", "abcd abcd")))
+	r.CaseSensitive = false
+	require.Equal(t, "0.77", sf(r.Compare("PRIVATE", "privet")))
+}
+
+func TestSift4(t *testing.T) {
+	s := metrics.NewSift4()
+	require.Equal(t, 0, s.Distance("", ""))
+	require.Equal(t, "1.00", sf(s.Compare("", "")))
+	require.Equal(t, 4, s.Distance("test", ""))
+	require.Equal(t, 4, s.Distance("", "test"))
+	require.Equal(t, 2, s.Distance("London", "Lond"))
+	require.Equal(t, 1, s.Distance("ab", "ba"))
+	require.Equal(t, 1, s.Distance("this is a test", "this is a tset"))
+	require.Equal(t, 2, s.Distance("abcdefghij", "abdcefhgij"))
+	require.Equal(t, 3, s.Distance("kitten", "sitting"))
+	require.Equal(t, 2, s.Distance("bbed", "deeb"))
+	require.Equal(t, 1, s.Distance("ab\u2019d", "ab\u2019c"))
+	require.Equal(t, "0.40", sf(s.Compare("book", "brick")))
+	require.Equal(t, "0.75", sf(s.Compare("ab\u2019d", "ab\u2019c")))
+	require.Equal(t, 3, s.Distance("abcdefghijklmnop", "xyzabcdefghijklmnop"))
+	s.MaxOffset = 2
+	require.Equal(t, 19, s.Distance("abcdefghijklmnop", "xyzabcdefghijklmnop"))
+	s.MaxOffset = 0
+	require.Equal(t, 3, s.Distance("abcdefghijklmnop", "xyzabcdefghijklmnop"))
+	s.MaxDistance = 1
+	require.Equal(t, 2, s.Distance("book", "brick"))
+	require.Equal(t, 2, s.Distance("aaabbb", "bbbaaa"))
+	s.MaxDistance = 0
+	s.Simple = true
+	require.Equal(t, 3, s.Distance("book", "brick"))
+	require.Equal(t, 3, s.Distance("bbed", "deeb"))
+	require.Equal(t, 2, s.Distance("London", "Lond"))
+	s.CaseSensitive = false
+	require.Equal(t, 2, s.Distance("LONDON", "lond"))
 }
 
 func TestSmithWatermanGotoh(t *testing.T) {
@@ -122,6 +712,22 @@ func TestSmithWatermanGotoh(t *testing.T) {
 		Mismatch: -0.5,
 	}
 	require.Equal(t, "0.94", sf(s.Compare("a pink kitten", "A KITTEN")))
+
+	// Affine gap penalties.
+	s = metrics.NewSmithWatermanGotoh()
+	s.GapOpen = -1
+	s.GapExtend = -0.1
+	require.Equal(t, "1.00", sf(s.Compare("", "")))
+	require.Equal(t, "0.00", sf(s.Compare("test", "")))
+	require.Equal(t, "1.00", sf(s.Compare("ab\u2019c", "ab\u2019c")))
+	require.Equal(t, "0.88", sf(s.Compare("a pink kitten", "a kitten")))
+	require.Equal(t, "0.77", sf(s.Compare("main street 12", "main 12")))
+	require.Equal(t, "0.69", sf(s.Compare("main street 12", "mian stret 12")))
+	s.GapExtend = 0
+	require.Equal(t, "0.88", sf(s.Compare("a pink kitten", "a kitten")))
+	s.GapOpen = -0.5
+	s.GapExtend = -0.5
+	require.Equal(t, "0.88", sf(s.Compare("a pink kitten", "a kitten")))
 }
 
 func TestSorensenDice(t *testing.T) {
@@ -138,6 +744,241 @@ func TestSorensenDice(t *testing.T) {
 	require.Equal(t, "0.60", sf(s.Compare("night", "ALRIGHT")))
 	s.NgramSize = 3
 	require.Equal(t, "0.50", sf(s.Compare("night", "alright")))
+	s.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "0.75", sf(s.Compare("Red cotton shirt, large", "large shirt cotton blue")))
+	require.Equal(t, "0.00", sf(s.Compare("", "large shirt")))
+	require.Equal(t, "0.00", sf(s.Compare("...", "!!!")))
+}
+
+func TestTokenSort(t *testing.T) {
+	ts := metrics.NewTokenSort()
+	require.Equal(t, "1.00", sf(ts.Compare("", "")))
+	require.Equal(t, "0.00", sf(ts.Compare("a", "")))
+	require.Equal(t, "0.00", sf(ts.Compare("", "a")))
+	require.Equal(t, "1.00", sf(ts.Compare("ab\u2019c d", "d  ab\u2019c")))
+	require.Equal(t, "1.00", sf(ts.Compare("fuzzy wuzzy was a bear", "wuzzy fuzzy was a bear")))
+	require.Equal(t, "1.00", sf(ts.Compare("new york mets vs atlanta braves", "atlanta braves vs new york mets")))
+	require.Equal(t, "0.84", sf(ts.Compare("fuzzy was a bear", "fuzzy fuzzy was a bear")))
+	require.Equal(t, "0.43", sf(ts.Compare("Apple Inc", "Apple Inc. Cupertino Headquarters")))
+	require.Equal(t, "0.91", sf(ts.Compare("h\u00e9llo w\u00f6rld", "w\u00f6rld hello")))
+	require.Equal(t, "0.77", sf(ts.Compare("New York Mets", "new york mets")))
+
+	// Custom options.
+	ts.CaseSensitive = false
+	require.Equal(t, "1.00", sf(ts.Compare("New York Mets", "mets new york")))
+	ts.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "1.00", sf(ts.Compare("New York, Mets", "mets new york")))
+	ts.CaseSensitive = true
+	ts.Metric = &metrics.LCS{CaseSensitive: false}
+	require.Equal(t, "0.69", sf(ts.Compare("new York, Mets", "mets new york")))
+	ts.Metric = metrics.NewLevenshtein()
+	require.Equal(t, "1.00", sf(ts.Compare(" ", "")))
+	ts.Tokenizer = nil
+	ts.Metric = nil
+	require.Equal(t, "0.84", sf(ts.Compare("fuzzy was a bear", "fuzzy fuzzy was a bear")))
+}
+
+func TestTokenSet(t *testing.T) {
+	ts := metrics.NewTokenSet()
+	require.Equal(t, "0.00", sf(ts.Compare("", "")))
+	require.Equal(t, "0.00", sf(ts.Compare("a", "")))
+	require.Equal(t, "0.00", sf(ts.Compare(" ", "a")))
+	require.Equal(t, "1.00", sf(ts.Compare("ab\u2019c d", "d d ab\u2019c")))
+	require.Equal(t, "1.00", sf(ts.Compare("fuzzy was a bear", "fuzzy fuzzy was a bear")))
+	require.Equal(t, "0.91", sf(ts.Compare("mariners vs angels", "los angeles angels of anaheim at seattle mariners")))
+	require.Equal(t, "0.71", sf(ts.Compare("Apple Inc", "Apple Inc. Cupertino Headquarters")))
+	require.Equal(t, "0.57", sf(ts.Compare("a b york new xy", "ab xy ba inc.")))
+	require.Equal(t, "0.46", sf(ts.Compare("inc. ba inc a", "apple inc xy cupertino")))
+	require.Equal(t, "0.00", sf(ts.Compare("abc", "xyz")))
+
+	// Custom options.
+	ts.CaseSensitive = false
+	require.Equal(t, "0.71", sf(ts.Compare("Apple Inc", "apple inc. Cupertino Headquarters")))
+	ts.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "1.00", sf(ts.Compare("Apple Inc", "apple inc. Cupertino Headquarters")))
+	ts.Tokenizer = metrics.NewRegexpTokenizer(regexp.MustCompile(`[A-Za-z]+`))
+	require.Equal(t, "1.00", sf(ts.Compare("Apple Inc", "Apple Inc. Cupertino Headquarters")))
+	ts.Tokenizer = nil
+	ts.Metric = nil
+	require.Equal(t, "0.71", sf(ts.Compare("Apple Inc", "Apple Inc. Cupertino Headquarters")))
+}
+
+func TestTversky(t *testing.T) {
+	tv := metrics.NewTversky()
+	require.Equal(t, "1.00", sf(tv.Compare("", "")))
+	require.Equal(t, "0.00", sf(tv.Compare("a", "b")))
+	require.Equal(t, "0.00", sf(tv.Compare("test", "")))
+	require.Equal(t, "1.00", sf(tv.Compare("ab\u2019c", "ab\u2019c")))
+
+	// Sorensen-Dice special case.
+	sd := metrics.NewSorensenDice()
+	require.Equal(t, sf(sd.Compare("night", "alright")), sf(tv.Compare("night", "alright")))
+	require.Equal(t, sf(sd.Compare("abab", "ab")), sf(tv.Compare("abab", "ab")))
+
+	// Jaccard special case.
+	j := metrics.NewJaccard()
+	tv.Alpha, tv.Beta = 1, 1
+	require.Equal(t, sf(j.Compare("night", "alright")), sf(tv.Compare("night", "alright")))
+	require.Equal(t, sf(j.Compare("abab", "ab")), sf(tv.Compare("abab", "ab")))
+
+	// Asymmetric weights.
+	tv.Alpha, tv.Beta = 1, 0
+	require.Equal(t, "1.00", sf(tv.Compare("make", "time to make haste")))
+	require.Equal(t, "0.18", sf(tv.Compare("time to make haste", "make")))
+	require.Equal(t, "0.75", sf(tv.Compare("night", "alright")))
+	tv.Alpha, tv.Beta = -1, -1
+	require.Equal(t, "1.00", sf(tv.Compare("night", "alright")))
+
+	// Custom options.
+	tv.Alpha, tv.Beta = 0.8, 0.2
+	tv.CaseSensitive = false
+	tv.NgramSize = 3
+	require.Equal(t, "0.59", sf(tv.Compare("Night", "ALRIGHT")))
+	tv.Alpha, tv.Beta = 1, 0
+	tv.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, "1.00", sf(tv.Compare("cotton shirt", "large shirt, cotton blue")))
+	require.Equal(t, "0.50", sf(tv.Compare("large shirt, cotton blue", "cotton shirt")))
+}
+
+func TestWRatio(t *testing.T) {
+	w := metrics.NewWRatio()
+	require.Equal(t, "0.00", sf(w.Compare("", "")))
+	require.Equal(t, "0.00", sf(w.Compare("a", "")))
+	require.Equal(t, "1.00", sf(w.Compare("ab\u2019c", "ab\u2019c")))
+
+	score := func(a, b string) [2]string {
+		similarity, scorer := w.Score(a, b)
+		return [2]string{sf(similarity), string(scorer)}
+	}
+
+	// Terms of similar lengths.
+	require.Equal(t, [2]string{"0.97", "ratio"}, score("this is a test", "this is a test!"))
+	require.Equal(t, [2]string{"0.95", "token_sort"}, score("fuzzy wuzzy was a bear", "wuzzy fuzzy was a bear"))
+	require.Equal(t, [2]string{"0.72", "token_set"}, score("new york mets", "new YORK mets"))
+
+	// Terms of different lengths.
+	require.Equal(t, [2]string{"0.90", "partial"}, score("york", "new york mets"))
+	require.Equal(t, [2]string{"0.85", "partial_token_set"}, score("mets new", "new york mets vs braves"))
+	require.Equal(t, [2]string{"0.60", "partial"}, score("apple", "apple inc cupertino headquarters california"))
+	require.Equal(t, [2]string{"0.60", "partial_token_sort"}, score("bear wuzzy", "fuzzy wuzzi was a bear, a big one"))
+	require.Equal(t, [2]string{"0.60", "partial"}, score("inc", "apple inc cupertino headquarters california"))
+	require.Equal(t, [2]string{"0.57", "partial_token_set"}, score("inc q", "apple inc cupertino headquarters california usa"))
+
+	// Custom options.
+	w.CaseSensitive = false
+	require.Equal(t, [2]string{"1.00", "ratio"}, score("new york mets", "new YORK mets"))
+	w.Tokenizer = metrics.NewWordTokenizer()
+	require.Equal(t, [2]string{"0.95", "token_sort"}, score("Mets, New York", "new york mets"))
+	w.Metric = metrics.NewLevenshtein()
+	require.Equal(t, [2]string{"0.92", "ratio"}, score("new york mets", "new york jets"))
+}
+
+func TestWhitespaceTokenizer(t *testing.T) {
+	tk := metrics.NewWhitespaceTokenizer()
+	require.Empty(t, tk.Tokenize(""))
+	require.Empty(t, tk.Tokenize(" \t\n"))
+	require.Equal(t, []string{"Hello,", "world!"}, tk.Tokenize(" Hello,\t world! "))
+}
+
+func TestWordTokenizer(t *testing.T) {
+	tk := metrics.NewWordTokenizer()
+	require.Nil(t, tk.Tokenize(""))
+	require.Nil(t, tk.Tokenize("-- ... !"))
+	require.Equal(t, []string{"Hello", "world"}, tk.Tokenize("Hello, world!"))
+	require.Equal(t,
+		[]string{"don't", "stop", "quoted", "e.g", "3.14", "1,000", "a", "b"},
+		tk.Tokenize("don't stop 'quoted' e.g. 3.14 1,000 a,b"),
+	)
+	require.Equal(t, []string{"snake_case", "na\u00efve", "ab\u2019c"}, tk.Tokenize("snake_case na\u00efve ab\u2019c"))
+	require.Equal(t, []string{"東", "京", "タワー", "へ", "行", "く"}, tk.Tokenize("東京タワーへ行く"))
+}
+
+func TestRegexpTokenizer(t *testing.T) {
+	tk := metrics.NewRegexpTokenizer(regexp.MustCompile(`\d+`))
+	require.Nil(t, tk.Tokenize(""))
+	require.Nil(t, tk.Tokenize("abc"))
+	require.Equal(t, []string{"1", "22", "333"}, tk.Tokenize("a1 b22 c333"))
+	tk.Regexp = nil
+	require.Nil(t, tk.Tokenize("a1 b22 c333"))
+}
+
+func TestNgramTokenizer(t *testing.T) {
+	tk := metrics.NewNgramTokenizer(3)
+	require.Nil(t, tk.Tokenize(""))
+	require.Nil(t, tk.Tokenize("ab"))
+	require.Equal(t, []string{"abc", "bcd"}, tk.Tokenize("abcd"))
+	tk.Size = 0
+	require.Equal(t, []string{"ab", "bc", "cd"}, tk.Tokenize("abcd"))
+}
+
+func TestWordNgramTokenizer(t *testing.T) {
+	tk := metrics.NewWordNgramTokenizer(2)
+	require.Nil(t, tk.Tokenize(""))
+	require.Nil(t, tk.Tokenize("fox"))
+	require.Equal(t,
+		[]string{"The quick", "quick brown", "brown fox"},
+		tk.Tokenize("The quick, brown fox"),
+	)
+	tk.Size = 3
+	tk.Tokenizer = nil
+	require.Equal(t, []string{"the quick brown", "quick brown fox"}, tk.Tokenize("the quick brown fox"))
+	tk.Tokenizer = metrics.NewWhitespaceTokenizer()
+	require.Equal(t, []string{"the quick, brown"}, tk.Tokenize("the quick, brown"))
+}
+
+func TestSubstitutionMatrix(t *testing.T) {
+	matrix := `
+#  BLOSUM62 excerpt.
+   A  R  N  D  *
+A  4 -1 -2 -2 -4
+R -1  5  0 -2 -4
+N -2  0  6  1 -4
+D -2 -2  1  6 -4
+* -4 -4 -4 -4  1
+`
+	m, err := metrics.ParseSubstitutionMatrix(strings.NewReader(matrix))
+	require.NoError(t, err)
+	require.Equal(t, "6.00", sf(m.Max()))
+	require.Equal(t, "-4.00", sf(m.Min()))
+	require.Equal(t, "4.00", sf(m.Compare([]rune("A"), 0, []rune("A"), 0)))
+	require.Equal(t, "1.00", sf(m.Compare([]rune("N"), 0, []rune("D"), 0)))
+	require.Equal(t, "1.00", sf(m.Compare([]rune("d"), 0, []rune("n"), 0)))
+	require.Equal(t, "-4.00", sf(m.Compare([]rune("A"), 0, []rune("W"), 0)))
+	require.Equal(t, "1.00", sf(m.Compare([]rune("W"), 0, []rune("\u2019"), 0)))
+
+	s := metrics.NewSmithWatermanGotoh()
+	s.Substitution = m
+	require.Equal(t, "0.88", sf(s.Compare("ARND", "ARND")))
+	require.Equal(t, "0.60", sf(s.Compare("ARND", "ARDN")))
+
+	m, err = metrics.ParseSubstitutionMatrix(strings.NewReader("  a b\na 1.5 -1\nb -1 2"))
+	require.NoError(t, err)
+	require.Equal(t, "2.00", sf(m.Max()))
+	require.Equal(t, "-1.00", sf(m.Min()))
+	require.Equal(t, "-1.00", sf(m.Compare([]rune("a"), 0, []rune("c"), 0)))
+
+	for _, invalid := range []string{
+		"",
+		"# Comment only.",
+		"   A  R",
+		"   A  RR\nA 1 2",
+		"   A  R\nA 1",
+		"   A  R\nA 1 2 3",
+		"   A  R\nA 1 x",
+		"   A  R\nAA 1 2",
+		"   A  R\nA 1 2\nA 1 2",
+		"   A  A\nA 1 2",
+		"   A  R\nA 1 2",
+		"   A  R\nR 1 2\nB 1 2",
+	} {
+		_, err := metrics.ParseSubstitutionMatrix(strings.NewReader(invalid))
+		require.Error(t, err)
+	}
+
+	_, err = metrics.ParseSubstitutionMatrix(strings.NewReader("  A A\nA 1 2\n"))
+	require.EqualError(t, err, `line 1: duplicate column 'A'`)
+	_, err = metrics.ParseSubstitutionMatrix(strings.NewReader("  A R\nA 1 2\n"))
+	require.EqualError(t, err, `missing row 'R'`)
 }
 
 func TestMatchMismatch(t *testing.T) {
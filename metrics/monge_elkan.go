@@ -0,0 +1,104 @@
+package metrics
+
+import "github.com/adrg/strutil"
+
+// MongeElkan represents the Monge-Elkan metric for measuring the similarity
+// between sequences. The metric is a hybrid token based metric: the compared
+// terms are split into tokens (e.g. words) and each token of the first term
+// is matched with the most similar token of the second term, using an inner
+// string metric. The returned similarity is the average of the best match
+// scores. The metric is not sensitive to the order of the tokens, which
+// makes it suitable for matching multi-word names.
+//
+// The Monge-Elkan similarity is not symmetric, as it only takes into account
+// the tokens of the first term. A symmetric variant, which averages the
+// similarities computed in both directions, can be used instead.
+//
+// For more information see "The field matching problem: algorithms and
+// applications" by A. Monge and C. Elkan.
+type MongeElkan struct {
+	// Tokenizer represents the tokenizer used to split the input sequences
+	// into tokens. The WhitespaceTokenizer is used if no tokenizer is
+	// specified.
+	Tokenizer Tokenizer
+
+	// Metric represents the string metric used to compare the tokens of
+	// the input sequences. The Jaro-Winkler metric is used if no metric
+	// is specified. Case sensitivity is controlled by the inner metric.
+	Metric strutil.StringMetric
+
+	// Symmetric specifies if the symmetric variant of the metric is used.
+	// If true, the returned similarity is the average of the Monge-Elkan
+	// similarities of a and b, and b and a.
+	Symmetric bool
+}
+
+// NewMongeElkan returns a new Monge-Elkan string metric.
+//
+// Default options:
+//
+//	Tokenizer: NewWhitespaceTokenizer()
+//	Metric: NewJaroWinkler()
+//	Symmetric: false
+func NewMongeElkan() *MongeElkan {
+	return &MongeElkan{
+		Tokenizer: NewWhitespaceTokenizer(),
+		Metric:    NewJaroWinkler(),
+	}
+}
+
+// Compare returns the Monge-Elkan similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *MongeElkan) Compare(a, b string) float64 {
+	tokenizer := m.Tokenizer
+	if tokenizer == nil {
+		tokenizer = NewWhitespaceTokenizer()
+	}
+
+	// Check if both terms are empty.
+	tokensA, tokensB := tokenizer.Tokenize(a), tokenizer.Tokenize(b)
+	lenA, lenB := len(tokensA), len(tokensB)
+	if lenA == 0 && lenB == 0 {
+		return 1
+	}
+
+	// Check if one of the terms is empty.
+	if lenA == 0 || lenB == 0 {
+		return 0
+	}
+
+	metric := m.Metric
+	if metric == nil {
+		metric = NewJaroWinkler()
+	}
+
+	// Find the best matches of the tokens of both terms.
+	bestA, bestB := make([]float64, lenA), make([]float64, lenB)
+	for i, tokenA := range tokensA {
+		for j, tokenB := range tokensB {
+			sim := metric.Compare(tokenA, tokenB)
+			if sim > bestA[i] {
+				bestA[i] = sim
+			}
+			if sim > bestB[j] {
+				bestB[j] = sim
+			}
+		}
+	}
+
+	// Return similarity.
+	if !m.Symmetric {
+		return average(bestA)
+	}
+	return (average(bestA) + average(bestB)) / 2
+}
+
+func average(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
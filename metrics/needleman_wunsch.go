@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// NeedlemanWunsch represents the Needleman-Wunsch metric for measuring the
+// similarity between sequences. Unlike the Smith-Waterman-Gotoh metric, which
+// scores the best local alignment of the compared sequences, the metric
+// scores the global alignment of the sequences, end to end.
+//
+// For more information see https://en.wikipedia.org/wiki/Needleman-Wunsch_algorithm.
+type NeedlemanWunsch struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// GapPenalty defines a score penalty for character insertions or deletions.
+	// For relevant results, the gap penalty should be a non-positive number.
+	GapPenalty float64
+
+	// Substitution represents a substitution function which is used to
+	// calculate a score for character substitutions.
+	Substitution Substitution
+}
+
+// NewNeedlemanWunsch returns a new Needleman-Wunsch string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	GapPenalty: -1
+//	Substitution: MatchMismatch{
+//		Match:    1,
+//		Mismatch: -1,
+//	},
+func NewNeedlemanWunsch() *NeedlemanWunsch {
+	return &NeedlemanWunsch{
+		CaseSensitive: true,
+		GapPenalty:    -1,
+		Substitution: MatchMismatch{
+			Match:    1,
+			Mismatch: -1,
+		},
+	}
+}
+
+// Compare returns the Needleman-Wunsch similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *NeedlemanWunsch) Compare(a, b string) float64 {
+	gap := m.GapPenalty
+
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if both terms are empty.
+	lenA, lenB := len(runesA), len(runesB)
+	if lenA == 0 && lenB == 0 {
+		return 1
+	}
+
+	// Check if one of the terms is empty.
+	if lenA == 0 || lenB == 0 {
+		return 0
+	}
+
+	// Use default substitution, if none is specified.
+	subst := m.Substitution
+	if subst == nil {
+		subst = MatchMismatch{
+			Match:    1,
+			Mismatch: -1,
+		}
+	}
+
+	// Calculate the score range of the alignment.
+	maxLen := float64(mathutil.Max(lenA, lenB))
+	maxScore := maxLen * mathutil.Maxf(subst.Max(), gap)
+	minScore := maxLen * mathutil.Minf(subst.Min(), gap)
+	if maxScore <= minScore {
+		return 1
+	}
+
+	// Calculate alignment score.
+	v0 := make([]float64, lenB+1)
+	v1 := make([]float64, lenB+1)
+	for i := 1; i <= lenB; i++ {
+		v0[i] = v0[i-1] + gap
+	}
+
+	for i := 1; i <= lenA; i++ {
+		v1[0] = v0[0] + gap
+		for j := 1; j <= lenB; j++ {
+			v1[j] = mathutil.Maxf(v0[j]+gap, v1[j-1]+gap, v0[j-1]+subst.Compare(runesA, i-1, runesB, j-1))
+		}
+
+		v0, v1 = v1, v0
+	}
+
+	// Return similarity.
+	return (v0[lenB] - minScore) / (maxScore - minScore)
+}
@@ -0,0 +1,99 @@
+package metrics
+
+import "unicode/utf8"
+
+// OCRConfusion represents a substitution function which scores characters
+// commonly confused by optical character recognition (OCR) software as near
+// matches (e.g. "0" and "O", "5" and "S", "1" and "l"). Besides single
+// characters, the function also defines scores for confused character
+// sequences of different lengths (e.g. "rn" and "m"), which are used by the
+// edit distance metrics supporting sequence substitutions (see the
+// SequenceSubstitution interface).
+type OCRConfusion struct {
+	// Match represents the score of equal character substitutions.
+	Match float64
+
+	// Mismatch represents the score of substitutions between characters
+	// which are not commonly confused.
+	Mismatch float64
+
+	// Confusions maps pairs of commonly confused character sequences to
+	// their substitution score. The score should be between the mismatch
+	// and the match values. The order of the sequences in a pair is not
+	// relevant. Entries can be added, modified or removed in order to
+	// customize the confusion table.
+	Confusions map[[2]string]float64
+}
+
+// NewOCRConfusion returns a new OCR confusion substitution function, which
+// uses a default confusion table.
+//
+// Default options:
+//
+//	Match: 1
+//	Mismatch: -2
+//	Confusions: {
+//		{"0", "O"}: 0.5, {"0", "o"}: 0.5, {"0", "D"}: 0.5, {"O", "D"}: 0.5,
+//		{"1", "l"}: 0.5, {"1", "I"}: 0.5, {"l", "I"}: 0.5, {"1", "i"}: 0.5,
+//		{"5", "S"}: 0.5, {"5", "s"}: 0.5, {"8", "B"}: 0.5, {"2", "Z"}: 0.5,
+//		{"6", "G"}: 0.5, {"6", "b"}: 0.5, {"9", "g"}: 0.5, {"9", "q"}: 0.5,
+//		{"u", "v"}: 0.5, {"c", "e"}: 0.5, {"rn", "m"}: 0.5, {"cl", "d"}: 0.5,
+//		{"vv", "w"}: 0.5, {"ri", "n"}: 0.5, {"li", "h"}: 0.5, {"nn", "m"}: 0.5,
+//	}
+func NewOCRConfusion() *OCRConfusion {
+	return &OCRConfusion{
+		Match:    1,
+		Mismatch: -2,
+		Confusions: map[[2]string]float64{
+			{"0", "O"}: 0.5, {"0", "o"}: 0.5, {"0", "D"}: 0.5, {"O", "D"}: 0.5,
+			{"1", "l"}: 0.5, {"1", "I"}: 0.5, {"l", "I"}: 0.5, {"1", "i"}: 0.5,
+			{"5", "S"}: 0.5, {"5", "s"}: 0.5, {"8", "B"}: 0.5, {"2", "Z"}: 0.5,
+			{"6", "G"}: 0.5, {"6", "b"}: 0.5, {"9", "g"}: 0.5, {"9", "q"}: 0.5,
+			{"u", "v"}: 0.5, {"c", "e"}: 0.5, {"rn", "m"}: 0.5, {"cl", "d"}: 0.5,
+			{"vv", "w"}: 0.5, {"ri", "n"}: 0.5, {"li", "h"}: 0.5, {"nn", "m"}: 0.5,
+		},
+	}
+}
+
+// Compare returns the match value if a[idxA] is equal to b[idxB], the score
+// defined in the confusion table if the characters are commonly confused or
+// the mismatch value otherwise.
+func (m *OCRConfusion) Compare(a []rune, idxA int, b []rune, idxB int) float64 {
+	ra, rb := a[idxA], b[idxB]
+	if ra == rb {
+		return m.Match
+	}
+
+	sa, sb := string(ra), string(rb)
+	if score, ok := m.Confusions[[2]string{sa, sb}]; ok {
+		return score
+	}
+	if score, ok := m.Confusions[[2]string{sb, sa}]; ok {
+		return score
+	}
+
+	return m.Mismatch
+}
+
+// Max returns the match value.
+func (m *OCRConfusion) Max() float64 {
+	return m.Match
+}
+
+// Min returns the mismatch value.
+func (m *OCRConfusion) Min() float64 {
+	return m.Mismatch
+}
+
+// Sequences returns the entries of the confusion table in which at least one
+// of the sequences contains multiple characters.
+func (m *OCRConfusion) Sequences() map[[2]string]float64 {
+	sequences := map[[2]string]float64{}
+	for pair, score := range m.Confusions {
+		if utf8.RuneCountInString(pair[0]) > 1 || utf8.RuneCountInString(pair[1]) > 1 {
+			sequences[pair] = score
+		}
+	}
+
+	return sequences
+}
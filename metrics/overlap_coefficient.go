@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/adrg/strutil/internal/mathutil"
-	"github.com/adrg/strutil/internal/ngram"
 )
 
 // OverlapCoefficient represents the overlap coefficient for measuring the
@@ -19,6 +18,12 @@ type OverlapCoefficient struct {
 	// NgramSize represents the size (in characters) of the tokens generated
 	// when comparing the input sequences.
 	NgramSize int
+
+	// Tokenizer represents an optional tokenizer used to split the input
+	// sequences into tokens. If specified, the tokens returned by the
+	// tokenizer (e.g. words) are compared instead of the character n-grams
+	// of the sequences, and the n-gram size is ignored.
+	Tokenizer Tokenizer
 }
 
 // NewOverlapCoefficient returns a new overlap coefficient string metric.
@@ -27,6 +32,7 @@ type OverlapCoefficient struct {
 //
 //	CaseSensitive: true
 //	NGramSize: 2
+//	Tokenizer: nil
 func NewOverlapCoefficient() *OverlapCoefficient {
 	return &OverlapCoefficient{
 		CaseSensitive: true,
@@ -56,8 +62,8 @@ func (m *OverlapCoefficient) Compare(a, b string) float64 {
 		size = 2
 	}
 
-	// Calculate n-gram intersection and minimum subset.
-	_, common, totalA, totalB := ngram.Intersection(runesA, runesB, size)
+	// Calculate token intersection and minimum subset.
+	common, totalA, totalB := tokenIntersection(runesA, runesB, size, m.Tokenizer)
 
 	min := mathutil.Min(totalA, totalB)
 	if min == 0 {
@@ -0,0 +1,196 @@
+package metrics
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/adrg/strutil"
+)
+
+// Partial represents the partial match metric for measuring the similarity
+// between sequences. The shorter of the compared terms is slid over the
+// longer one and compared with each substring (window) of the longer term,
+// using an inner string metric. The returned similarity is the best score of
+// any window. Besides full length windows, the windows partially overlapping
+// the start and the end of the longer term are also compared. As a result,
+// the metric is suitable for finding short terms embedded in longer texts
+// (e.g. company names in bank transaction descriptions).
+//
+// Using the default inner metric (LCS), the returned similarity matches the
+// partial_ratio score of the RapidFuzz Python library, divided by 100, if the
+// shorter term has at most 64 characters. For longer terms, RapidFuzz uses a
+// heuristic which only compares some of the windows.
+type Partial struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// Metric represents the string metric used to compare the shorter term
+	// with the windows of the longer term. The LCS metric is used if no
+	// metric is specified. Other metrics, such as Levenshtein, can be used
+	// as well.
+	Metric strutil.StringMetric
+}
+
+// PartialAlignment represents the best alignment of two terms compared using
+// the partial match metric. The offsets are byte offsets in the compared
+// terms: a[StartA:EndA] is the part of a which is aligned with b[StartB:EndB].
+// One of the aligned parts is always the shorter term in its entirety.
+type PartialAlignment struct {
+	// Score represents the similarity of the aligned parts of the terms.
+	Score float64
+
+	// StartA and EndA represent the offsets of the aligned part of a.
+	StartA, EndA int
+
+	// StartB and EndB represent the offsets of the aligned part of b.
+	StartB, EndB int
+}
+
+// NewPartial returns a new partial match string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	Metric: NewLCS()
+func NewPartial() *Partial {
+	return &Partial{
+		CaseSensitive: true,
+		Metric:        NewLCS(),
+	}
+}
+
+// Compare returns the partial match similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *Partial) Compare(a, b string) float64 {
+	return m.Align(a, b).Score
+}
+
+// Align returns the best alignment of a and b, along with its score. The
+// score is a number between 0 and 1. Larger scores indicate closer matches.
+func (m *Partial) Align(a, b string) PartialAlignment {
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if one of the terms is empty.
+	lenA, lenB := len(runesA), len(runesB)
+	if lenA == 0 || lenB == 0 {
+		var score float64
+		if lenA == lenB {
+			score = 1
+		}
+		return PartialAlignment{Score: score, EndA: len(a), EndB: len(b)}
+	}
+
+	// Lower terms if case insensitive comparison is specified. The runes
+	// are lowered individually, in order to preserve the offsets.
+	if !m.CaseSensitive {
+		runesA, runesB = lowerRunes(runesA), lowerRunes(runesB)
+	}
+
+	metric := m.Metric
+	if metric == nil {
+		metric = NewLCS()
+	}
+
+	// Slide the shorter term over the longer one. If the terms have the same
+	// length, both of them are slid over the other one.
+	var alignment runeAlignment
+	if lenA <= lenB {
+		alignment = partialAlign(metric, runesA, runesB)
+	}
+	if lenA >= lenB && alignment.score < 1 {
+		if swapped := partialAlign(metric, runesB, runesA).swap(); lenA > lenB || swapped.score > alignment.score {
+			alignment = swapped
+		}
+	}
+
+	// Convert rune offsets to byte offsets.
+	return PartialAlignment{
+		Score:  alignment.score,
+		StartA: byteOffset(a, alignment.startA),
+		EndA:   byteOffset(a, alignment.endA),
+		StartB: byteOffset(b, alignment.startB),
+		EndB:   byteOffset(b, alignment.endB),
+	}
+}
+
+type runeAlignment struct {
+	score        float64
+	startA, endA int
+	startB, endB int
+}
+
+func (a runeAlignment) swap() runeAlignment {
+	return runeAlignment{
+		score:  a.score,
+		startA: a.startB,
+		endA:   a.endB,
+		startB: a.startA,
+		endB:   a.endA,
+	}
+}
+
+// partialAlign compares the shorter term with all the windows of the longer
+// term and returns the best alignment. Windows which start or end with
+// characters not found in the shorter term are skipped, as they cannot
+// produce better alignments than the neighbouring windows.
+func partialAlign(metric strutil.StringMetric, shorter, longer []rune) runeAlignment {
+	lenS, lenL := len(shorter), len(longer)
+	term := string(shorter)
+
+	chars := make(map[rune]struct{}, lenS)
+	for _, r := range shorter {
+		chars[r] = struct{}{}
+	}
+
+	best := runeAlignment{endA: lenS, endB: lenS}
+	compare := func(start, end int) bool {
+		if score := metric.Compare(term, string(longer[start:end])); score > best.score {
+			best = runeAlignment{score: score, endA: lenS, startB: start, endB: end}
+		}
+		return best.score >= 1
+	}
+
+	// Compare windows overlapping the start of the longer term.
+	for i := 1; i < lenS; i++ {
+		if _, ok := chars[longer[i-1]]; ok && compare(0, i) {
+			return best
+		}
+	}
+
+	// Compare full length windows.
+	for i := 0; i < lenL-lenS; i++ {
+		if _, ok := chars[longer[i+lenS-1]]; ok && compare(i, i+lenS) {
+			return best
+		}
+	}
+
+	// Compare windows overlapping the end of the longer term.
+	for i := lenL - lenS; i < lenL; i++ {
+		if _, ok := chars[longer[i]]; ok && compare(i, lenL) {
+			return best
+		}
+	}
+
+	return best
+}
+
+func lowerRunes(runes []rune) []rune {
+	lowered := make([]rune, len(runes))
+	for i, r := range runes {
+		lowered[i] = unicode.ToLower(r)
+	}
+
+	return lowered
+}
+
+// byteOffset returns the byte offset of the rune with the specified index.
+func byteOffset(s string, runeIdx int) int {
+	var offset int
+	for i := 0; i < runeIdx && offset < len(s); i++ {
+		_, size := utf8.DecodeRuneInString(s[offset:])
+		offset += size
+	}
+
+	return offset
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil/internal/ngram"
+)
+
+// QGram represents Ukkonen's q-gram metric for measuring the similarity
+// between sequences. The q-gram distance between two terms is the sum of the
+// absolute differences between the frequencies of their n-grams (the L1
+// distance of their n-gram frequency vectors). Optionally, the compared terms
+// can be padded, so that their first and last characters are part of as many
+// n-grams as the other characters.
+//
+// For more information see "Approximate string-matching with q-grams and
+// maximal matches" by E. Ukkonen.
+type QGram struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// NgramSize represents the size (in characters) of the tokens generated
+	// when comparing the input sequences.
+	NgramSize int
+
+	// Padding specifies if the compared terms are padded with NgramSize-1
+	// start and end sentinel characters before generating the n-grams.
+	// Padding increases the weight of the first and last characters of the
+	// terms, which improves the scores of short terms.
+	Padding bool
+}
+
+// NewQGram returns a new q-gram string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	NGramSize: 2
+//	Padding: false
+func NewQGram() *QGram {
+	return &QGram{
+		CaseSensitive: true,
+		NgramSize:     2,
+	}
+}
+
+// Compare returns the q-gram similarity of a and b. The returned similarity
+// is a number between 0 and 1. Larger similarity numbers indicate closer
+// matches. The similarity is obtained by normalizing the q-gram distance to
+// the total number of n-grams of the compared terms. If neither term is long
+// enough to generate n-grams, the similarity is 1 for equal terms and 0
+// otherwise.
+// An n-gram size of 2 is used if the provided size is less than or equal to 0.
+func (m *QGram) Compare(a, b string) float64 {
+	distance, total := m.distance(a, b)
+	if total == 0 {
+		// Terms shorter than the n-gram size are only similar if equal.
+		if !m.CaseSensitive {
+			a, b = strings.ToLower(a), strings.ToLower(b)
+		}
+		if a == b {
+			return 1
+		}
+		return 0
+	}
+
+	return 1 - float64(distance)/float64(total)
+}
+
+// Distance returns the q-gram distance between a and b. Lower distances
+// indicate closer matches. A distance of 0 means the terms have the same
+// n-gram frequencies. Terms shorter than the n-gram size have no n-grams.
+// An n-gram size of 2 is used if the provided size is less than or equal to 0.
+func (m *QGram) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *QGram) distance(a, b string) (int, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	size := m.NgramSize
+	if size <= 0 {
+		size = 2
+	}
+
+	// Pad terms, if specified.
+	runesA, runesB := []rune(a), []rune(b)
+	if m.Padding {
+		runesA, runesB = ngram.Pad(runesA, size), ngram.Pad(runesB, size)
+	}
+
+	// Calculate n-gram intersection. The n-grams which are not common to
+	// both terms account for the difference between their frequencies.
+	_, common, totalA, totalB := ngram.Intersection(runesA, runesB, size)
+	return totalA + totalB - 2*common, totalA + totalB
+}
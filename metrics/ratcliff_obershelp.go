@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"strings"
+)
+
+// RatcliffObershelp represents the Ratcliff/Obershelp metric (also known as
+// gestalt pattern matching) for measuring the similarity between sequences.
+// The implementation follows the one of the SequenceMatcher type from the
+// difflib package of the Python standard library, so the returned
+// similarities match the ones returned by SequenceMatcher.ratio().
+//
+// For more information see https://en.wikipedia.org/wiki/Gestalt_pattern_matching.
+type RatcliffObershelp struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// Autojunk specifies if the popular characters of the second term are
+	// treated as junk. If the second term has at least 200 characters, each
+	// character which accounts for more than 1% of the term (plus one
+	// character) is considered popular. This is the equivalent of the
+	// autojunk parameter of Python's SequenceMatcher.
+	Autojunk bool
+
+	// IsJunk specifies a function which reports if a character of the second
+	// term is junk. Junk characters cannot start a matching block, but they
+	// can extend one found between non-junk characters. If nil, no character
+	// is considered junk. This is the equivalent of the isjunk parameter of
+	// Python's SequenceMatcher.
+	IsJunk func(r rune) bool
+}
+
+// NewRatcliffObershelp returns a new Ratcliff/Obershelp string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	Autojunk: true
+//	IsJunk: nil
+func NewRatcliffObershelp() *RatcliffObershelp {
+	return &RatcliffObershelp{
+		CaseSensitive: true,
+		Autojunk:      true,
+	}
+}
+
+// Compare returns the Ratcliff/Obershelp similarity of a and b. The
+// similarity is calculated as twice the number of matching characters divided
+// by the total number of characters in the terms. The returned similarity is
+// a number between 0 and 1. Larger similarity numbers indicate closer matches.
+func (m *RatcliffObershelp) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if both terms are empty.
+	lenA, lenB := len(runesA), len(runesB)
+	if lenA == 0 && lenB == 0 {
+		return 1
+	}
+
+	// Calculate the number of matching characters. The matching blocks are
+	// found by recursively looking for the longest matching block to the
+	// left and to the right of the previously found one.
+	matcher := m.newMatcher(runesA, runesB)
+
+	var matches int
+	queue := [][4]int{{0, lenA, 0, lenB}}
+	for len(queue) > 0 {
+		block := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		loA, hiA, loB, hiB := block[0], block[1], block[2], block[3]
+		i, j, size := matcher.longestMatch(loA, hiA, loB, hiB)
+		if size == 0 {
+			continue
+		}
+		matches += size
+
+		if loA < i && loB < j {
+			queue = append(queue, [4]int{loA, i, loB, j})
+		}
+		if i+size < hiA && j+size < hiB {
+			queue = append(queue, [4]int{i + size, hiA, j + size, hiB})
+		}
+	}
+
+	// Return similarity.
+	return 2 * float64(matches) / float64(lenA+lenB)
+}
+
+type gestaltMatcher struct {
+	a, b    []rune
+	indices map[rune][]int
+	junk    map[rune]struct{}
+}
+
+func (m *RatcliffObershelp) newMatcher(a, b []rune) *gestaltMatcher {
+	// Map the characters of the second term to their indices.
+	indices := map[rune][]int{}
+	for i, r := range b {
+		indices[r] = append(indices[r], i)
+	}
+
+	// Remove junk characters.
+	junk := map[rune]struct{}{}
+	if m.IsJunk != nil {
+		for r := range indices {
+			if m.IsJunk(r) {
+				junk[r] = struct{}{}
+				delete(indices, r)
+			}
+		}
+	}
+
+	// Remove popular characters.
+	if lenB := len(b); m.Autojunk && lenB >= 200 {
+		limit := lenB/100 + 1
+		for r, idxs := range indices {
+			if len(idxs) > limit {
+				delete(indices, r)
+			}
+		}
+	}
+
+	return &gestaltMatcher{
+		a:       a,
+		b:       b,
+		indices: indices,
+		junk:    junk,
+	}
+}
+
+// longestMatch returns the start indices and the size of the longest matching
+// block of a[loA:hiA] and b[loB:hiB]. Of all the longest matching blocks, the
+// one which starts earliest in a is returned and, of all the blocks which
+// start earliest in a, the one which starts earliest in b is returned.
+func (g *gestaltMatcher) longestMatch(loA, hiA, loB, hiB int) (int, int, int) {
+	a, b := g.a, g.b
+
+	// Find the longest matching block which contains no junk characters.
+	bestA, bestB, bestSize := loA, loB, 0
+
+	lengths := map[int]int{}
+	for i := loA; i < hiA; i++ {
+		newLengths := map[int]int{}
+		for _, j := range g.indices[a[i]] {
+			if j < loB {
+				continue
+			}
+			if j >= hiB {
+				break
+			}
+
+			size := lengths[j-1] + 1
+			newLengths[j] = size
+			if size > bestSize {
+				bestA, bestB, bestSize = i-size+1, j-size+1, size
+			}
+		}
+
+		lengths = newLengths
+	}
+
+	// Extend the block with matching popular characters, followed by
+	// matching junk characters, on both sides.
+	for _, junk := range []bool{false, true} {
+		for bestA > loA && bestB > loB && g.isJunk(b[bestB-1]) == junk &&
+			a[bestA-1] == b[bestB-1] {
+			bestA, bestB, bestSize = bestA-1, bestB-1, bestSize+1
+		}
+		for bestA+bestSize < hiA && bestB+bestSize < hiB &&
+			g.isJunk(b[bestB+bestSize]) == junk &&
+			a[bestA+bestSize] == b[bestB+bestSize] {
+			bestSize++
+		}
+	}
+
+	return bestA, bestB, bestSize
+}
+
+func (g *gestaltMatcher) isJunk(r rune) bool {
+	_, ok := g.junk[r]
+	return ok
+}
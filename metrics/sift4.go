@@ -0,0 +1,237 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// Sift4 represents the Sift4 metric for measuring the similarity between
+// sequences. Sift4 is a fast algorithm which approximates the Levenshtein
+// distance in close to linear time, by looking for matching characters only
+// within a limited offset of the current position in the compared sequences.
+// The common variant of the algorithm also counts transpositions, while the
+// simple variant does not.
+//
+// For more information see https://siderite.dev/blog/super-fast-and-accurate-string-distance.html.
+type Sift4 struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// MaxOffset represents the maximum number of characters to search for
+	// matching characters, in both sequences, when a mismatch is encountered.
+	// An offset of 5 is used if the provided offset is less than or equal to 0.
+	MaxOffset int
+
+	// MaxDistance specifies a distance at which the computation is stopped.
+	// If the computed distance exceeds the maximum distance, the distance
+	// calculated up to that point is returned. This is useful when only
+	// sequences within a certain distance are of interest. If the maximum
+	// distance is less than or equal to 0, the computation is never stopped
+	// early. The maximum distance is only used by the common variant.
+	MaxDistance int
+
+	// Simple specifies if the simple variant of the algorithm is used
+	// instead of the common one. The simple variant is faster, but it does
+	// not account for transpositions.
+	Simple bool
+}
+
+// NewSift4 returns a new Sift4 string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	MaxOffset: 5
+//	MaxDistance: 0
+//	Simple: false
+func NewSift4() *Sift4 {
+	return &Sift4{
+		CaseSensitive: true,
+		MaxOffset:     5,
+		MaxDistance:   0,
+		Simple:        false,
+	}
+}
+
+// Compare returns the Sift4 similarity of a and b. The returned similarity is
+// a number between 0 and 1. Larger similarity numbers indicate closer matches.
+func (m *Sift4) Compare(a, b string) float64 {
+	distance, maxLen := m.distance(a, b)
+	if maxLen == 0 {
+		return 1
+	}
+
+	return mathutil.Maxf(0, 1-float64(distance)/float64(maxLen))
+}
+
+// Distance returns the Sift4 distance between a and b. Lower distances
+// indicate closer matches. A distance of 0 means the strings are identical.
+func (m *Sift4) Distance(a, b string) int {
+	distance, _ := m.distance(a, b)
+	return distance
+}
+
+func (m *Sift4) distance(a, b string) (int, int) {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+	runesA, runesB := []rune(a), []rune(b)
+
+	// Check if one of the terms is empty.
+	lenA, lenB := len(runesA), len(runesB)
+	maxLen := mathutil.Max(lenA, lenB)
+	if lenA == 0 || lenB == 0 {
+		return maxLen, maxLen
+	}
+
+	maxOffset := m.MaxOffset
+	if maxOffset <= 0 {
+		maxOffset = 5
+	}
+
+	// Calculate distance.
+	if m.Simple {
+		return m.simpleDistance(runesA, runesB, maxOffset), maxLen
+	}
+	return m.commonDistance(runesA, runesB, maxOffset), maxLen
+}
+
+func (m *Sift4) simpleDistance(runesA, runesB []rune, maxOffset int) int {
+	lenA, lenB := len(runesA), len(runesB)
+
+	var idxA, idxB, common, localCommon int
+	for idxA < lenA && idxB < lenB {
+		if runesA[idxA] == runesB[idxB] {
+			localCommon++
+		} else {
+			common += localCommon
+			localCommon = 0
+
+			if idxA != idxB {
+				idxA = mathutil.Max(idxA, idxB)
+				idxB = idxA
+			}
+
+			// Look for matching characters within the maximum offset.
+			for i := 0; i < maxOffset && (idxA+i < lenA || idxB+i < lenB); i++ {
+				if idxA+i < lenA && idxB < lenB && runesA[idxA+i] == runesB[idxB] {
+					idxA += i
+					localCommon++
+					break
+				}
+				if idxB+i < lenB && idxA < lenA && runesA[idxA] == runesB[idxB+i] {
+					idxB += i
+					localCommon++
+					break
+				}
+			}
+		}
+
+		idxA++
+		idxB++
+	}
+	common += localCommon
+
+	return mathutil.Max(lenA, lenB) - common
+}
+
+type sift4Offset struct {
+	idxA, idxB int
+	trans      bool
+}
+
+func (m *Sift4) commonDistance(runesA, runesB []rune, maxOffset int) int {
+	lenA, lenB := len(runesA), len(runesB)
+
+	var (
+		idxA, idxB          int
+		common, localCommon int
+		trans               int
+		offsets             []sift4Offset
+	)
+	for idxA < lenA && idxB < lenB {
+		if runesA[idxA] == runesB[idxB] {
+			localCommon++
+
+			// Check if the current match is a transposition. When two matches
+			// cross, the one with the largest offset difference is considered
+			// a transposition.
+			var isTrans bool
+			for i := 0; i < len(offsets); {
+				offset := &offsets[i]
+				if idxA <= offset.idxA || idxB <= offset.idxB {
+					isTrans = mathutil.Abs(idxB-idxA) >= mathutil.Abs(offset.idxB-offset.idxA)
+					if isTrans {
+						trans++
+					} else if !offset.trans {
+						offset.trans = true
+						trans++
+					}
+					break
+				}
+
+				if idxA > offset.idxB && idxB > offset.idxA {
+					offsets = append(offsets[:i], offsets[i+1:]...)
+				} else {
+					i++
+				}
+			}
+
+			offsets = append(offsets, sift4Offset{
+				idxA:  idxA,
+				idxB:  idxB,
+				trans: isTrans,
+			})
+		} else {
+			common += localCommon
+			localCommon = 0
+
+			if idxA != idxB {
+				idxA = mathutil.Min(idxA, idxB)
+				idxB = idxA
+			}
+
+			// Stop early if the maximum distance is exceeded.
+			if m.MaxDistance > 0 {
+				if distance := mathutil.Max(idxA, idxB) - common + trans; distance > m.MaxDistance {
+					return distance
+				}
+			}
+
+			// Look for matching characters within the maximum offset. If
+			// found, the indices are set before the matching characters, as
+			// they are incremented at the end of the loop.
+			for i := 0; i < maxOffset && (idxA+i < lenA || idxB+i < lenB); i++ {
+				if idxA+i < lenA && runesA[idxA+i] == runesB[idxB] {
+					idxA += i - 1
+					idxB--
+					break
+				}
+				if idxB+i < lenB && runesA[idxA] == runesB[idxB+i] {
+					idxA--
+					idxB += i - 1
+					break
+				}
+			}
+		}
+
+		idxA++
+		idxB++
+
+		// Account for the last match, in case the end of one of the terms is
+		// reached, so that transpositions are correctly computed.
+		if idxA >= lenA || idxB >= lenB {
+			common += localCommon
+			localCommon = 0
+
+			idxA = mathutil.Min(idxA, idxB)
+			idxB = idxA
+		}
+	}
+	common += localCommon
+
+	return mathutil.Max(lenA, lenB) - common + trans
+}
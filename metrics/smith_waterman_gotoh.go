@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"math"
 	"strings"
 
 	"github.com/adrg/strutil/internal/mathutil"
@@ -16,8 +17,23 @@ type SmithWatermanGotoh struct {
 
 	// GapPenalty defines a score penalty for character insertions or deletions.
 	// For relevant results, the gap penalty should be a non-positive number.
+	// The gap penalty is only used if both GapOpen and GapExtend are 0.
 	GapPenalty float64
 
+	// GapOpen defines a score penalty for the first character of a gap
+	// (a sequence of consecutive character insertions or deletions). If either
+	// GapOpen or GapExtend is not 0, affine gap penalties are used instead of
+	// GapPenalty. For relevant results, the penalty should be a non-positive
+	// number, lower than or equal to GapExtend.
+	GapOpen float64
+
+	// GapExtend defines a score penalty for each additional character of a gap
+	// (a sequence of consecutive character insertions or deletions). If either
+	// GapOpen or GapExtend is not 0, affine gap penalties are used instead of
+	// GapPenalty. For relevant results, the penalty should be a non-positive
+	// number, greater than or equal to GapOpen.
+	GapExtend float64
+
 	// Substitution represents a substitution function which is used to
 	// calculate a score for character substitutions.
 	Substitution Substitution
@@ -29,6 +45,8 @@ type SmithWatermanGotoh struct {
 //
 //	CaseSensitive: true
 //	GapPenalty: -0.5
+//	GapOpen: 0
+//	GapExtend: 0
 //	Substitution: MatchMismatch{
 //		Match:    1,
 //		Mismatch: -2,
@@ -37,6 +55,8 @@ func NewSmithWatermanGotoh() *SmithWatermanGotoh {
 	return &SmithWatermanGotoh{
 		CaseSensitive: true,
 		GapPenalty:    -0.5,
+		GapOpen:       0,
+		GapExtend:     0,
 		Substitution: MatchMismatch{
 			Match:    1,
 			Mismatch: -2,
@@ -48,7 +68,11 @@ func NewSmithWatermanGotoh() *SmithWatermanGotoh {
 // similarity is a number between 0 and 1. Larger similarity numbers indicate
 // closer matches.
 func (m *SmithWatermanGotoh) Compare(a, b string) float64 {
-	gap := m.GapPenalty
+	// Use linear gap penalties, unless affine gap penalties are specified.
+	gapOpen, gapExtend := m.GapPenalty, m.GapPenalty
+	if m.GapOpen != 0 || m.GapExtend != 0 {
+		gapOpen, gapExtend = m.GapOpen, m.GapExtend
+	}
 
 	// Lower terms if case insensitive comparison is specified.
 	if !m.CaseSensitive {
@@ -78,32 +102,31 @@ func (m *SmithWatermanGotoh) Compare(a, b string) float64 {
 	}
 
 	// Calculate max distance.
-	maxDistance := mathutil.Minf(float64(lenA), float64(lenB)) * mathutil.Maxf(subst.Max(), gap)
+	maxDistance := mathutil.Minf(float64(lenA), float64(lenB)) * mathutil.Maxf(subst.Max(), gapOpen, gapExtend)
 
-	// Calculate distance.
-	v0 := make([]float64, lenB)
-	v1 := make([]float64, lenB)
+	// Calculate distance. Besides the alignment scores, the scores of the
+	// alignments ending with a gap in each of the terms are tracked, so that
+	// extending an existing gap can be scored differently than opening one.
+	v0 := make([]float64, lenB+1)
+	v1 := make([]float64, lenB+1)
 
-	distance := mathutil.Maxf(0, gap, subst.Compare(runesA, 0, runesB, 0))
-	v0[0] = distance
-
-	for i := 1; i < lenB; i++ {
-		v0[i] = mathutil.Maxf(0, v0[i-1]+gap, subst.Compare(runesA, 0, runesB, i))
-		distance = mathutil.Maxf(distance, v0[i])
+	gapsA := make([]float64, lenB+1)
+	for j := range gapsA {
+		gapsA[j] = math.Inf(-1)
 	}
 
-	for i := 1; i < lenA; i++ {
-		v1[0] = mathutil.Maxf(0, v0[0]+gap, subst.Compare(runesA, i, runesB, 0))
-		distance = mathutil.Maxf(distance, v1[0])
+	var distance float64
+	for i := 1; i <= lenA; i++ {
+		gapB := math.Inf(-1)
+		for j := 1; j <= lenB; j++ {
+			gapsA[j] = mathutil.Maxf(v0[j]+gapOpen, gapsA[j]+gapExtend)
+			gapB = mathutil.Maxf(v1[j-1]+gapOpen, gapB+gapExtend)
 
-		for j := 1; j < lenB; j++ {
-			v1[j] = mathutil.Maxf(0, v0[j]+gap, v1[j-1]+gap, v0[j-1]+subst.Compare(runesA, i, runesB, j))
+			v1[j] = mathutil.Maxf(0, gapsA[j], gapB, v0[j-1]+subst.Compare(runesA, i-1, runesB, j-1))
 			distance = mathutil.Maxf(distance, v1[j])
 		}
 
-		for j := 0; j < lenB; j++ {
-			v0[j] = v1[j]
-		}
+		v0, v1 = v1, v0
 	}
 
 	// Return similarity.
@@ -2,8 +2,6 @@ package metrics
 
 import (
 	"strings"
-
-	"github.com/adrg/strutil/internal/ngram"
 )
 
 // SorensenDice represents the Sorensen-Dice metric for measuring the
@@ -17,6 +15,12 @@ type SorensenDice struct {
 	// NgramSize represents the size (in characters) of the tokens generated
 	// when comparing the input sequences.
 	NgramSize int
+
+	// Tokenizer represents an optional tokenizer used to split the input
+	// sequences into tokens. If specified, the tokens returned by the
+	// tokenizer (e.g. words) are compared instead of the character n-grams
+	// of the sequences, and the n-gram size is ignored.
+	Tokenizer Tokenizer
 }
 
 // NewSorensenDice returns a new Sorensen-Dice string metric.
@@ -25,6 +29,7 @@ type SorensenDice struct {
 //
 //	CaseSensitive: true
 //	NGramSize: 2
+//	Tokenizer: nil
 func NewSorensenDice() *SorensenDice {
 	return &SorensenDice{
 		CaseSensitive: true,
@@ -54,8 +59,8 @@ func (m *SorensenDice) Compare(a, b string) float64 {
 		size = 2
 	}
 
-	// Calculate n-gram intersection and union.
-	_, common, totalA, totalB := ngram.Intersection(runesA, runesB, size)
+	// Calculate token intersection and union.
+	common, totalA, totalB := tokenIntersection(runesA, runesB, size, m.Tokenizer)
 
 	total := totalA + totalB
 	if total == 0 {
@@ -12,3 +12,87 @@ type Substitution interface {
 	// Returns the minimum score of a character substitution operation.
 	Min() float64
 }
+
+// SequenceSubstitution represents a substitution function which, besides
+// single characters, defines substitution scores for character sequences of
+// different lengths (e.g. "rn" and "m"). Edit distance metrics which support
+// sequence substitutions, such as Levenshtein, treat the substitution of a
+// whole sequence as a single edit operation.
+type SequenceSubstitution interface {
+	Substitution
+
+	// Sequences returns the pairs of character sequences, of which at least
+	// one contains multiple characters, along with their substitution scores.
+	// The order of the sequences in a pair is not relevant.
+	Sequences() map[[2]string]float64
+}
+
+type sequencePair struct {
+	a, b  []rune
+	score float64
+}
+
+// sequencePairs returns the non-empty character sequence pairs defined by
+// the specified substitution function, in both orders.
+func sequencePairs(subst SequenceSubstitution) []sequencePair {
+	var pairs []sequencePair
+	for pair, score := range subst.Sequences() {
+		a, b := []rune(pair[0]), []rune(pair[1])
+		if len(a) == 0 || len(b) == 0 {
+			continue
+		}
+
+		pairs = append(pairs,
+			sequencePair{a: a, b: b, score: score},
+			sequencePair{a: b, b: a, score: score},
+		)
+	}
+
+	return pairs
+}
+
+// hasSuffixAt returns true if runes[:end] ends with suffix.
+func hasSuffixAt(runes []rune, end int, suffix []rune) bool {
+	start := end - len(suffix)
+	if start < 0 {
+		return false
+	}
+
+	for i, r := range suffix {
+		if runes[start+i] != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replaceCost returns the cost of replacing a[idxA] with b[idxB] in edit
+// distance metrics. Replacing equal characters costs nothing. If no
+// substitution function is provided, the specified replacement cost is
+// returned for unequal characters. Otherwise, the substitution score of the
+// characters is mapped linearly from the [Min, Max] score range of the
+// substitution function to the [cost, 0] cost range, so that characters with
+// a higher substitution score are cheaper to replace.
+func replaceCost(subst Substitution, cost float64, a []rune, idxA int, b []rune, idxB int) float64 {
+	if a[idxA] == b[idxB] {
+		return 0
+	}
+	if subst == nil {
+		return cost
+	}
+
+	return scoreCost(subst, cost, subst.Compare(a, idxA, b, idxB))
+}
+
+// scoreCost maps the specified substitution score linearly from the
+// [Min, Max] score range of the substitution function to the [cost, 0]
+// cost range.
+func scoreCost(subst Substitution, cost, score float64) float64 {
+	max, min := subst.Max(), subst.Min()
+	if max <= min {
+		return cost
+	}
+
+	return cost * (max - score) / (max - min)
+}
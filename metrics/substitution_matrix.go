@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SubstitutionMatrix represents a substitution function which scores
+// characters using a substitution matrix, such as the BLOSUM or the PAM
+// matrices used for aligning protein sequences.
+type SubstitutionMatrix struct {
+	scores map[[2]rune]float64
+	chars  map[rune]struct{}
+	min    float64
+	max    float64
+}
+
+// ParseSubstitutionMatrix reads a substitution matrix from the specified
+// reader. The matrix must use the whitespace separated format of the standard
+// BLOSUM and PAM matrix files: the first line contains the characters of the
+// matrix columns, and each of the following lines contains a row character,
+// followed by the scores of the row character and each of the column
+// characters. Each column character must be unique and must have a row.
+// Empty lines and lines starting with # are ignored.
+//
+// Example:
+//
+//	# Comment.
+//	   A  C  G  T
+//	A  5 -4 -4 -4
+//	C -4  5 -4 -4
+//	G -4 -4  5 -4
+//	T -4 -4 -4  5
+func ParseSubstitutionMatrix(r io.Reader) (*SubstitutionMatrix, error) {
+	var (
+		columns []rune
+		line    int
+	)
+	m := &SubstitutionMatrix{
+		scores: map[[2]rune]float64{},
+		chars:  map[rune]struct{}{},
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+	}
+	rows := map[rune]struct{}{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		// Parse header.
+		if columns == nil {
+			for _, field := range fields {
+				c, err := parseMatrixChar(field)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line, err)
+				}
+				if _, ok := m.chars[c]; ok {
+					return nil, fmt.Errorf("line %d: duplicate column %q", line, c)
+				}
+				columns = append(columns, c)
+				m.chars[c] = struct{}{}
+			}
+			continue
+		}
+
+		// Parse row.
+		row, err := parseMatrixChar(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if _, ok := rows[row]; ok {
+			return nil, fmt.Errorf("line %d: duplicate row %q", line, row)
+		}
+		rows[row] = struct{}{}
+		m.chars[row] = struct{}{}
+
+		if values := fields[1:]; len(values) != len(columns) {
+			return nil, fmt.Errorf("line %d: expected %d scores, got %d", line, len(columns), len(values))
+		}
+		for i, field := range fields[1:] {
+			score, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid score %q", line, field)
+			}
+
+			m.scores[[2]rune{row, columns[i]}] = score
+			m.min = math.Min(m.min, score)
+			m.max = math.Max(m.max, score)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(m.scores) == 0 {
+		return nil, fmt.Errorf("substitution matrix contains no scores")
+	}
+	for _, c := range columns {
+		if _, ok := rows[c]; !ok {
+			return nil, fmt.Errorf("missing row %q", c)
+		}
+	}
+
+	return m, nil
+}
+
+func parseMatrixChar(field string) (rune, error) {
+	if utf8.RuneCountInString(field) != 1 {
+		return 0, fmt.Errorf("invalid matrix character %q", field)
+	}
+
+	r, _ := utf8.DecodeRuneInString(field)
+	return r, nil
+}
+
+// Compare returns the substitution score of a[idxA] and b[idxB], as defined
+// in the substitution matrix. If the characters are not found in the matrix,
+// the upper case versions of the characters are looked up. Characters which
+// are still not found are replaced with the * character, which is used by
+// the standard matrices to score unknown characters. If no score is found,
+// the minimum score of the matrix is returned.
+func (m *SubstitutionMatrix) Compare(a []rune, idxA int, b []rune, idxB int) float64 {
+	ra, rb := m.lookup(a[idxA]), m.lookup(b[idxB])
+	if score, ok := m.scores[[2]rune{ra, rb}]; ok {
+		return score
+	}
+
+	return m.min
+}
+
+func (m *SubstitutionMatrix) lookup(r rune) rune {
+	if _, ok := m.chars[r]; ok {
+		return r
+	}
+	if upper := unicode.ToUpper(r); upper != r {
+		if _, ok := m.chars[upper]; ok {
+			return upper
+		}
+	}
+	if _, ok := m.chars['*']; ok {
+		return '*'
+	}
+
+	return r
+}
+
+// Max returns the maximum score of the substitution matrix.
+func (m *SubstitutionMatrix) Max() float64 {
+	return m.max
+}
+
+// Min returns the minimum score of the substitution matrix.
+func (m *SubstitutionMatrix) Min() float64 {
+	return m.min
+}
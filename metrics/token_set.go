@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"strings"
+
+	"github.com/adrg/strutil"
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// TokenSet represents the token set metric for measuring the similarity
+// between sequences. The unique tokens of the compared terms are split into
+// three groups: the tokens common to both terms, the tokens found only in the
+// first term and the tokens found only in the second term. The tokens of each
+// group are sorted and joined using a single space, producing the following
+// strings:
+//
+//	t0 = common tokens
+//	t1 = t0 + tokens found only in the first term
+//	t2 = t0 + tokens found only in the second term
+//
+// The returned similarity is the maximum similarity of the pairs (t0, t1),
+// (t0, t2) and (t1, t2), compared using an inner string metric. As a result,
+// terms are considered identical if the tokens of one of them are a subset of
+// the tokens of the other one. The metric is not sensitive to the order of
+// the tokens or to repeated tokens.
+//
+// Using the default options, the returned similarity matches the
+// token_set_ratio score of the RapidFuzz Python library (version 3 or later,
+// with no processor), divided by 100. Unlike the defaults of the fuzzywuzzy
+// library, the terms are not lowered or stripped of non-alphanumeric
+// characters, and the similarity is not rounded.
+type TokenSet struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	// If false, the input sequences are lowered before being tokenized.
+	CaseSensitive bool
+
+	// Tokenizer represents the tokenizer used to split the input sequences
+	// into tokens. The WhitespaceTokenizer is used if no tokenizer is
+	// specified.
+	Tokenizer Tokenizer
+
+	// Metric represents the string metric used to compare the token groups
+	// of the input sequences. The LCS metric is used if no metric is
+	// specified.
+	Metric strutil.StringMetric
+}
+
+// NewTokenSet returns a new token set string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	Tokenizer: NewWhitespaceTokenizer()
+//	Metric: NewLCS()
+func NewTokenSet() *TokenSet {
+	return &TokenSet{
+		CaseSensitive: true,
+		Tokenizer:     NewWhitespaceTokenizer(),
+		Metric:        NewLCS(),
+	}
+}
+
+// Compare returns the token set similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches. A similarity of 0 is returned if any of the terms has no
+// tokens.
+func (m *TokenSet) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	tokenizer := m.Tokenizer
+	if tokenizer == nil {
+		tokenizer = NewWhitespaceTokenizer()
+	}
+
+	// Check if one of the terms has no tokens.
+	tokensA, tokensB := uniqueTokens(tokenizer, a), uniqueTokens(tokenizer, b)
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return 0
+	}
+
+	// Split tokens into common tokens and tokens found in only one term.
+	var common, diffA, diffB []string
+	for token := range tokensA {
+		if _, ok := tokensB[token]; ok {
+			common = append(common, token)
+		} else {
+			diffA = append(diffA, token)
+		}
+	}
+	for token := range tokensB {
+		if _, ok := tokensA[token]; !ok {
+			diffB = append(diffB, token)
+		}
+	}
+
+	// Check if the tokens of one of the terms are a subset of the tokens of
+	// the other one.
+	if len(common) > 0 && (len(diffA) == 0 || len(diffB) == 0) {
+		return 1
+	}
+
+	metric := m.Metric
+	if metric == nil {
+		metric = NewLCS()
+	}
+
+	t0 := joinSorted(common)
+	t1 := strings.TrimSpace(t0 + " " + joinSorted(diffA))
+	t2 := strings.TrimSpace(t0 + " " + joinSorted(diffB))
+
+	// If there are no common tokens, only t1 and t2 are compared.
+	similarity := metric.Compare(t1, t2)
+	if len(common) == 0 {
+		return similarity
+	}
+
+	return mathutil.Maxf(similarity, metric.Compare(t0, t1), metric.Compare(t0, t2))
+}
+
+// uniqueTokens returns the set of unique tokens of the specified term.
+func uniqueTokens(tokenizer Tokenizer, term string) map[string]struct{} {
+	tokens := tokenizer.Tokenize(term)
+
+	set := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		set[token] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/adrg/strutil"
+)
+
+// TokenSort represents the token sort metric for measuring the similarity
+// between sequences. The compared terms are split into tokens, which are
+// sorted and joined back together using a single space. The resulting terms
+// are then compared using an inner string metric. As a result, the metric is
+// not sensitive to the order of the tokens in the compared terms.
+//
+// Using the default options, the returned similarity matches the
+// token_sort_ratio score of the RapidFuzz Python library (version 3 or later,
+// with no processor), divided by 100. Unlike the defaults of the fuzzywuzzy
+// library, the terms are not lowered or stripped of non-alphanumeric
+// characters, and the similarity is not rounded.
+type TokenSort struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	// If false, the input sequences are lowered before being tokenized.
+	CaseSensitive bool
+
+	// Tokenizer represents the tokenizer used to split the input sequences
+	// into tokens. The WhitespaceTokenizer is used if no tokenizer is
+	// specified.
+	Tokenizer Tokenizer
+
+	// Metric represents the string metric used to compare the sorted tokens
+	// of the input sequences. The LCS metric is used if no metric is
+	// specified.
+	Metric strutil.StringMetric
+}
+
+// NewTokenSort returns a new token sort string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	Tokenizer: NewWhitespaceTokenizer()
+//	Metric: NewLCS()
+func NewTokenSort() *TokenSort {
+	return &TokenSort{
+		CaseSensitive: true,
+		Tokenizer:     NewWhitespaceTokenizer(),
+		Metric:        NewLCS(),
+	}
+}
+
+// Compare returns the token sort similarity of a and b. The returned
+// similarity is a number between 0 and 1. Larger similarity numbers indicate
+// closer matches.
+func (m *TokenSort) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	tokenizer := m.Tokenizer
+	if tokenizer == nil {
+		tokenizer = NewWhitespaceTokenizer()
+	}
+
+	metric := m.Metric
+	if metric == nil {
+		metric = NewLCS()
+	}
+
+	return metric.Compare(
+		joinSorted(tokenizer.Tokenize(a)),
+		joinSorted(tokenizer.Tokenize(b)),
+	)
+}
+
+// joinSorted sorts the specified tokens and joins them using a single space.
+func joinSorted(tokens []string) string {
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
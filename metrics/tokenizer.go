@@ -0,0 +1,249 @@
+package metrics
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/adrg/strutil/internal/ngram"
+)
+
+// Tokenizer represents a function which splits text into tokens. Token based
+// metrics, such as Jaccard, Sorensen-Dice or the overlap coefficient, can use
+// a tokenizer in order to compare the words or the word n-grams of the input
+// sequences, instead of their character n-grams.
+type Tokenizer interface {
+	// Tokenize returns the tokens of the specified text, in the order in
+	// which they occur in the text.
+	Tokenize(text string) []string
+}
+
+// WhitespaceTokenizer represents a tokenizer which splits text around each
+// sequence of one or more consecutive white space characters.
+type WhitespaceTokenizer struct{}
+
+// NewWhitespaceTokenizer returns a new whitespace tokenizer.
+func NewWhitespaceTokenizer() *WhitespaceTokenizer {
+	return &WhitespaceTokenizer{}
+}
+
+// Tokenize returns the whitespace separated tokens of the specified text.
+func (t *WhitespaceTokenizer) Tokenize(text string) []string {
+	return strings.Fields(text)
+}
+
+// WordTokenizer represents a tokenizer which splits text into words, using
+// an approximation of the Unicode word boundary rules (UAX #29). Words are
+// sequences of letters, digits, marks and connector punctuation characters
+// (e.g. "_"). Apostrophes and periods are kept if they occur between word
+// characters (e.g. "don't", "e.g"), while commas are kept if they occur
+// between digits (e.g. "1,000"). Ideographic and Hiragana characters are
+// returned as separate words. All other characters are discarded.
+type WordTokenizer struct{}
+
+// NewWordTokenizer returns a new Unicode word tokenizer.
+func NewWordTokenizer() *WordTokenizer {
+	return &WordTokenizer{}
+}
+
+// Tokenize returns the words of the specified text.
+func (t *WordTokenizer) Tokenize(text string) []string {
+	runes := []rune(text)
+	lenRunes := len(runes)
+
+	var (
+		tokens []string
+		start  = -1
+	)
+	for i := 0; i <= lenRunes; i++ {
+		if i < lenRunes {
+			r := runes[i]
+			if unicode.In(r, unicode.Han, unicode.Hiragana) {
+				if start >= 0 {
+					tokens = append(tokens, string(runes[start:i]))
+					start = -1
+				}
+				tokens = append(tokens, string(r))
+				continue
+			}
+			if isWordRune(r) {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+			if start >= 0 && i+1 < lenRunes && isMidWordRune(runes[i-1], r, runes[i+1]) {
+				continue
+			}
+		}
+
+		if start >= 0 {
+			tokens = append(tokens, string(runes[start:i]))
+			start = -1
+		}
+	}
+
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.N, unicode.M, unicode.Pc) &&
+		!unicode.In(r, unicode.Han, unicode.Hiragana)
+}
+
+func isMidWordRune(prev, r, next rune) bool {
+	switch r {
+	case '\'', '’', '.':
+		return isWordRune(prev) && isWordRune(next)
+	case ',':
+		return unicode.IsDigit(prev) && unicode.IsDigit(next)
+	}
+
+	return false
+}
+
+// RegexpTokenizer represents a tokenizer which returns the matches of a
+// regular expression as tokens.
+type RegexpTokenizer struct {
+	// Regexp represents the regular expression used to match the tokens.
+	Regexp *regexp.Regexp
+}
+
+// NewRegexpTokenizer returns a new regular expression tokenizer, which uses
+// the specified regular expression to match tokens.
+func NewRegexpTokenizer(re *regexp.Regexp) *RegexpTokenizer {
+	return &RegexpTokenizer{
+		Regexp: re,
+	}
+}
+
+// Tokenize returns all the successive non-overlapping matches of the regular
+// expression in the specified text. No tokens are returned if the tokenizer
+// has no regular expression.
+func (t *RegexpTokenizer) Tokenize(text string) []string {
+	if t.Regexp == nil {
+		return nil
+	}
+
+	return t.Regexp.FindAllString(text, -1)
+}
+
+// NgramTokenizer represents a tokenizer which splits text into overlapping
+// character n-grams.
+type NgramTokenizer struct {
+	// Size represents the size (in characters) of the generated n-grams.
+	Size int
+}
+
+// NewNgramTokenizer returns a new character n-gram tokenizer, which generates
+// n-grams of the specified size.
+func NewNgramTokenizer(size int) *NgramTokenizer {
+	return &NgramTokenizer{
+		Size: size,
+	}
+}
+
+// Tokenize returns the character n-grams of the specified text. An n-gram
+// size of 2 is used if the provided size is less than or equal to 0.
+func (t *NgramTokenizer) Tokenize(text string) []string {
+	size := t.Size
+	if size <= 0 {
+		size = 2
+	}
+
+	return ngram.Slice([]rune(text), size)
+}
+
+// WordNgramTokenizer represents a tokenizer which generates word n-grams
+// (also known as shingles): sequences of consecutive words, joined by a
+// single space.
+type WordNgramTokenizer struct {
+	// Tokenizer represents the tokenizer used to split the text into words.
+	// The WordTokenizer is used if no tokenizer is specified.
+	Tokenizer Tokenizer
+
+	// Size represents the size (in words) of the generated n-grams.
+	Size int
+}
+
+// NewWordNgramTokenizer returns a new word n-gram tokenizer, which generates
+// n-grams of the specified size.
+//
+// Default options:
+//
+//	Tokenizer: NewWordTokenizer()
+func NewWordNgramTokenizer(size int) *WordNgramTokenizer {
+	return &WordNgramTokenizer{
+		Tokenizer: NewWordTokenizer(),
+		Size:      size,
+	}
+}
+
+// Tokenize returns the word n-grams of the specified text. No n-grams are
+// returned if the text contains fewer words than the n-gram size. An n-gram
+// size of 2 is used if the provided size is less than or equal to 0.
+func (t *WordNgramTokenizer) Tokenize(text string) []string {
+	size := t.Size
+	if size <= 0 {
+		size = 2
+	}
+
+	tokenizer := t.Tokenizer
+	if tokenizer == nil {
+		tokenizer = NewWordTokenizer()
+	}
+
+	words := tokenizer.Tokenize(text)
+	if len(words) < size {
+		return nil
+	}
+
+	ngrams := make([]string, len(words)-(size-1))
+	for i := range ngrams {
+		ngrams[i] = strings.Join(words[i:i+size], " ")
+	}
+
+	return ngrams
+}
+
+// tokenMap returns a map of the tokens of the specified term, along with
+// their frequency, and the total number of tokens. If no tokenizer is
+// provided, the character n-grams of the specified size are used instead.
+func tokenMap(term []rune, size int, tokenizer Tokenizer) (map[string]int, int) {
+	if tokenizer == nil {
+		return ngram.Map(term, size)
+	}
+
+	tokens := tokenizer.Tokenize(string(term))
+	tokenMap := make(map[string]int, len(tokens))
+	for _, token := range tokens {
+		tokenMap[token]++
+	}
+
+	return tokenMap, len(tokens)
+}
+
+// tokenIntersection returns the number of tokens common to both terms, the
+// total number of tokens in the first term and the total number of tokens in
+// the second term. If no tokenizer is provided, the character n-grams of the
+// specified size are used instead.
+func tokenIntersection(a, b []rune, size int, tokenizer Tokenizer) (int, int, int) {
+	if tokenizer == nil {
+		_, common, totalA, totalB := ngram.Intersection(a, b, size)
+		return common, totalA, totalB
+	}
+
+	tokensA, totalA := tokenMap(a, size, tokenizer)
+	tokensB, totalB := tokenMap(b, size, tokenizer)
+
+	var common int
+	for token, countA := range tokensA {
+		if countB := tokensB[token]; countB < countA {
+			common += countB
+		} else {
+			common += countA
+		}
+	}
+
+	return common, totalA, totalB
+}
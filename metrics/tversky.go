@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"math"
+	"strings"
+)
+
+// Tversky represents the Tversky index for measuring the similarity between
+// sequences. The index is an asymmetric generalization of the Jaccard and
+// Sorensen-Dice coefficients, which weighs the n-grams found only in the
+// first term and the n-grams found only in the second term separately:
+//
+//	T(a, b) = |a ∩ b| / (|a ∩ b| + Alpha*|a - b| + Beta*|b - a|)
+//
+// Setting Alpha and Beta to 1 produces the Jaccard index, while setting them
+// to 0.5 produces the Sorensen-Dice coefficient. Setting Alpha to 1 and Beta
+// to 0 measures how much of the first term is contained in the second one,
+// which is useful when matching short queries against longer texts.
+//
+// For more information see https://en.wikipedia.org/wiki/Tversky_index.
+type Tversky struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// NgramSize represents the size (in characters) of the tokens generated
+	// when comparing the input sequences.
+	NgramSize int
+
+	// Tokenizer represents an optional tokenizer used to split the input
+	// sequences into tokens. If specified, the tokens returned by the
+	// tokenizer (e.g. words) are compared instead of the character n-grams
+	// of the sequences, and the n-gram size is ignored.
+	Tokenizer Tokenizer
+
+	// Alpha represents the weight of the n-grams which are found only in
+	// the first term. Negative values are treated as 0.
+	Alpha float64
+
+	// Beta represents the weight of the n-grams which are found only in
+	// the second term. Negative values are treated as 0.
+	Beta float64
+}
+
+// NewTversky returns a new Tversky index string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	NGramSize: 2
+//	Tokenizer: nil
+//	Alpha: 0.5
+//	Beta: 0.5
+func NewTversky() *Tversky {
+	return &Tversky{
+		CaseSensitive: true,
+		NgramSize:     2,
+		Alpha:         0.5,
+		Beta:          0.5,
+	}
+}
+
+// Compare returns the Tversky index of a and b. The returned similarity is a
+// number between 0 and 1. Larger similarity numbers indicate closer matches.
+// An n-gram size of 2 is used if the provided size is less than or equal to 0.
+func (m *Tversky) Compare(a, b string) float64 {
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	// Check if both terms are empty.
+	runesA, runesB := []rune(a), []rune(b)
+	if len(runesA) == 0 && len(runesB) == 0 {
+		return 1
+	}
+
+	size := m.NgramSize
+	if size <= 0 {
+		size = 2
+	}
+
+	// Calculate token intersection and differences.
+	common, totalA, totalB := tokenIntersection(runesA, runesB, size, m.Tokenizer)
+	if common == 0 {
+		return 0
+	}
+
+	alpha, beta := math.Max(m.Alpha, 0), math.Max(m.Beta, 0)
+	diffA, diffB := float64(totalA-common), float64(totalB-common)
+
+	// Return similarity.
+	return float64(common) / (float64(common) + alpha*diffA + beta*diffB)
+}
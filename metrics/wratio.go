@@ -0,0 +1,194 @@
+package metrics
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/adrg/strutil"
+	"github.com/adrg/strutil/internal/mathutil"
+)
+
+// WRatioScorer identifies the scorer which produced the similarity returned
+// by the WRatio metric.
+type WRatioScorer string
+
+// Scorers used by the WRatio metric.
+const (
+	// WRatioRatio represents the comparison of the terms using the inner
+	// metric.
+	WRatioRatio WRatioScorer = "ratio"
+
+	// WRatioTokenSort represents the token sort comparison of the terms.
+	WRatioTokenSort WRatioScorer = "token_sort"
+
+	// WRatioTokenSet represents the token set comparison of the terms.
+	WRatioTokenSet WRatioScorer = "token_set"
+
+	// WRatioPartial represents the partial match comparison of the terms.
+	WRatioPartial WRatioScorer = "partial"
+
+	// WRatioPartialTokenSort represents the partial match comparison of
+	// the sorted tokens of the terms.
+	WRatioPartialTokenSort WRatioScorer = "partial_token_sort"
+
+	// WRatioPartialTokenSet represents the partial match comparison of the
+	// unique tokens of the terms. Terms which have common tokens are
+	// considered a perfect partial match.
+	WRatioPartialTokenSet WRatioScorer = "partial_token_set"
+)
+
+// WRatio represents a weighted composite metric for measuring the similarity
+// between sequences. The metric combines the scores of the ratio (the inner
+// metric), token sort, token set and partial match scorers, using weights
+// which depend on the length ratio of the compared terms:
+//   - If the length of the longer term is less than 1.5 times the length of
+//     the shorter term, the returned similarity is the maximum of the ratio
+//     score and the token sort and token set scores, scaled by 0.95.
+//   - Otherwise, the partial match scorers are used instead of the token
+//     scorers. The partial match score is scaled by 0.9 and the partial match
+//     scores of the sorted and unique tokens are scaled by 0.95*0.9. If the
+//     length of the longer term is at least 8 times the length of the shorter
+//     term, the partial scale of 0.9 is replaced by 0.6.
+//
+// The metric is a good default for comparing free text, without having to
+// choose and tune a specific metric. Using the default inner metric (LCS),
+// the returned similarity matches the WRatio score of the RapidFuzz Python
+// library, divided by 100.
+type WRatio struct {
+	// CaseSensitive specifies if the string comparison is case sensitive.
+	CaseSensitive bool
+
+	// Tokenizer represents the tokenizer used to split the input sequences
+	// into tokens. The WhitespaceTokenizer is used if no tokenizer is
+	// specified.
+	Tokenizer Tokenizer
+
+	// Metric represents the string metric used by all the scorers in order
+	// to compare the input sequences or their tokens. The LCS metric is used
+	// if no metric is specified.
+	Metric strutil.StringMetric
+}
+
+// NewWRatio returns a new WRatio string metric.
+//
+// Default options:
+//
+//	CaseSensitive: true
+//	Tokenizer: NewWhitespaceTokenizer()
+//	Metric: NewLCS()
+func NewWRatio() *WRatio {
+	return &WRatio{
+		CaseSensitive: true,
+		Tokenizer:     NewWhitespaceTokenizer(),
+		Metric:        NewLCS(),
+	}
+}
+
+// Compare returns the WRatio similarity of a and b. The returned similarity
+// is a number between 0 and 1. Larger similarity numbers indicate closer
+// matches.
+func (m *WRatio) Compare(a, b string) float64 {
+	similarity, _ := m.Score(a, b)
+	return similarity
+}
+
+// Score returns the WRatio similarity of a and b, along with the scorer which
+// produced it. The returned similarity is a number between 0 and 1. Larger
+// similarity numbers indicate closer matches. If any of the terms is empty,
+// a similarity of 0 is returned, along with the ratio scorer.
+func (m *WRatio) Score(a, b string) (float64, WRatioScorer) {
+	const (
+		unbaseScale  = 0.95
+		partialScale = 0.9
+	)
+
+	// Lower terms if case insensitive comparison is specified.
+	if !m.CaseSensitive {
+		a = strings.ToLower(a)
+		b = strings.ToLower(b)
+	}
+
+	// Check if one of the terms is empty.
+	lenA, lenB := utf8.RuneCountInString(a), utf8.RuneCountInString(b)
+	if lenA == 0 || lenB == 0 {
+		return 0, WRatioRatio
+	}
+
+	tokenizer := m.Tokenizer
+	if tokenizer == nil {
+		tokenizer = NewWhitespaceTokenizer()
+	}
+
+	metric := m.Metric
+	if metric == nil {
+		metric = NewLCS()
+	}
+
+	best := wratioScore{similarity: metric.Compare(a, b), scorer: WRatioRatio}
+
+	// Use the token scorers for terms of similar lengths.
+	lenRatio := float64(mathutil.Max(lenA, lenB)) / float64(mathutil.Min(lenA, lenB))
+	if lenRatio < 1.5 {
+		tokenSort := &TokenSort{CaseSensitive: true, Tokenizer: tokenizer, Metric: metric}
+		tokenSet := &TokenSet{CaseSensitive: true, Tokenizer: tokenizer, Metric: metric}
+
+		best.update(tokenSort.Compare(a, b)*unbaseScale, WRatioTokenSort)
+		best.update(tokenSet.Compare(a, b)*unbaseScale, WRatioTokenSet)
+		return best.similarity, best.scorer
+	}
+
+	// Use the partial scorers for terms of different lengths.
+	scale := partialScale
+	if lenRatio >= 8 {
+		scale = 0.6
+	}
+
+	partial := &Partial{CaseSensitive: true, Metric: metric}
+	best.update(partial.Compare(a, b)*scale, WRatioPartial)
+
+	// Compare the tokens of the terms. Terms which have tokens in common are
+	// considered a perfect partial match.
+	tokensA, tokensB := tokenizer.Tokenize(a), tokenizer.Tokenize(b)
+	uniqueA, uniqueB := uniqueTokens(tokenizer, a), uniqueTokens(tokenizer, b)
+	for token := range uniqueA {
+		if _, ok := uniqueB[token]; ok {
+			best.update(unbaseScale*scale, WRatioPartialTokenSet)
+			return best.similarity, best.scorer
+		}
+	}
+
+	similarity := partial.Compare(joinSorted(tokensA), joinSorted(tokensB))
+	best.update(similarity*unbaseScale*scale, WRatioPartialTokenSort)
+
+	// If the terms have no repeated tokens, the unique tokens of the terms
+	// are the same as the sorted tokens, which have already been compared.
+	if len(tokensA) == len(uniqueA) && len(tokensB) == len(uniqueB) {
+		return best.similarity, best.scorer
+	}
+
+	similarity = partial.Compare(joinSorted(setTokens(uniqueA)), joinSorted(setTokens(uniqueB)))
+	best.update(similarity*unbaseScale*scale, WRatioPartialTokenSet)
+	return best.similarity, best.scorer
+}
+
+type wratioScore struct {
+	similarity float64
+	scorer     WRatioScorer
+}
+
+// update replaces the score if the specified similarity is higher.
+func (s *wratioScore) update(similarity float64, scorer WRatioScorer) {
+	if similarity > s.similarity {
+		s.similarity, s.scorer = similarity, scorer
+	}
+}
+
+// setTokens returns the tokens of the specified token set.
+func setTokens(set map[string]struct{}) []string {
+	tokens := make([]string, 0, len(set))
+	for token := range set {
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
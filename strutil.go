@@ -8,10 +8,26 @@ Included string metrics:
   - Jaro
   - Jaro-Winkler
   - Levenshtein
+  - Damerau-Levenshtein
+  - Bag distance
+  - Editex
+  - Longest common subsequence
+  - Longest common substring
+  - Ratcliff-Obershelp
+  - Sift4
   - Smith-Waterman-Gotoh
+  - Needleman-Wunsch
   - Sorensen-Dice
   - Jaccard
   - Overlap coefficient
+  - Cosine
+  - Tversky
+  - Q-gram
+  - Monge-Elkan
+  - Token sort
+  - Token set
+  - Partial
+  - WRatio
 */
 package strutil
 
@@ -26,10 +42,26 @@ import (
 //   - Jaro
 //   - Jaro-Winkler
 //   - Levenshtein
+//   - Damerau-Levenshtein
+//   - Bag distance
+//   - Editex
+//   - Longest common subsequence
+//   - Longest common substring
+//   - Ratcliff-Obershelp
+//   - Sift4
 //   - Smith-Waterman-Gotoh
+//   - Needleman-Wunsch
 //   - Sorensen-Dice
 //   - Jaccard
 //   - Overlap coefficient
+//   - Cosine
+//   - Tversky
+//   - Q-gram
+//   - Monge-Elkan
+//   - Token sort
+//   - Token set
+//   - Partial
+//   - WRatio
 //
 // For more information see https://pkg.go.dev/github.com/adrg/strutil/metrics.
 type StringMetric interface {
@@ -49,6 +81,23 @@ func CommonPrefix(a, b string) string {
 	return stringutil.CommonPrefix(a, b)
 }
 
+// LongestCommonSubsequence returns the longest common subsequence of the
+// specified strings. A subsequence is a sequence of characters which occur in
+// the same relative order, but not necessarily contiguously. An empty string
+// is returned if the parameters have no characters in common.
+func LongestCommonSubsequence(a, b string) string {
+	return stringutil.LongestCommonSubsequence(a, b)
+}
+
+// LongestCommonSubstring returns the longest common substring of the
+// specified strings, along with its byte offsets in a and b. If there are
+// multiple longest common substrings, the one which occurs first in b is
+// returned. An empty string and offsets of 0 are returned if the parameters
+// have no characters in common.
+func LongestCommonSubstring(a, b string) (string, int, int) {
+	return stringutil.LongestCommonSubstring(a, b)
+}
+
 // UniqueSlice returns a slice containing the unique items from the specified
 // string slice. The items in the output slice are in the order in which they
 // occur in the input slice.